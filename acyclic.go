@@ -1,6 +1,8 @@
 package gograph
 
 import (
+	"container/heap"
+	"fmt"
 	"sort"
 )
 
@@ -10,12 +12,9 @@ import (
 //
 // It returns error if it finds a cycle in the graph.
 func TopologySort[T comparable](g Graph[T]) ([]*Vertex[T], error) {
-	// Initialize a map to store the inDegree of each vertex
-	inDegrees := make(map[*Vertex[T]]int)
 	vertices := g.GetAllVertices()
-	for _, v := range vertices {
-		inDegrees[v] = v.inDegree
-	}
+	// Initialize a map to store the inDegree of each vertex
+	inDegrees := inDegreesOf(vertices)
 
 	// Initialize a queue with vertices of inDegrees zero
 	queue := make([]*Vertex[T], 0)
@@ -38,7 +37,7 @@ func TopologySort[T comparable](g Graph[T]) ([]*Vertex[T], error) {
 		sortedVertices = append(sortedVertices, curr)
 
 		// Decrement the inDegree of each of the vertex's neighbors
-		for _, neighbor := range curr.neighbors {
+		for _, neighbor := range curr.adjacent() {
 			inDegrees[neighbor]--
 			if inDegrees[neighbor] == 0 {
 				queue = append(queue, neighbor)
@@ -54,16 +53,105 @@ func TopologySort[T comparable](g Graph[T]) ([]*Vertex[T], error) {
 	return sortedVertices, nil
 }
 
+// TopologySortDFS performs a topological sort of the graph using a
+// depth-first search, and returns the vertices in reverse postorder, which
+// is the reverse of the order they are finished in. Unlike TopologySort, a
+// vertex comes right before the vertices that are finished just before it,
+// so the vertices of each DFS tree stay grouped. The search is iterative,
+// so it doesn't overflow the stack on deep graphs.
+//
+// It returns ErrDAGHasCycle if it finds an edge to a vertex that is still
+// on the DFS path, which means there is a cycle in the graph.
+func TopologySortDFS[T comparable](g Graph[T]) ([]*Vertex[T], error) {
+	postorder := make([]*Vertex[T], 0, g.Order())
+	err := depthFirstFinish(g, func(v *Vertex[T]) {
+		postorder = append(postorder, v)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, j := 0, len(postorder)-1; i < j; i, j = i+1, j-1 {
+		postorder[i], postorder[j] = postorder[j], postorder[i]
+	}
+
+	return postorder, nil
+}
+
+// IsDAG reports whether the graph is a directed acyclic graph. Unlike
+// TopologySort, it doesn't build an order: it runs a DFS that returns as
+// soon as it finds an edge to a vertex that is still on the DFS path, so a
+// cycle close to the start of the search is found without visiting the
+// rest of the graph. It takes O(V+E) time in the worst case.
+//
+// A DAG is directed by definition, so IsDAG returns false for every
+// undirected graph, including an empty or edgeless one, even though it has
+// no cycle.
+func IsDAG[T comparable](g Graph[T]) bool {
+	if !g.IsDirected() {
+		return false
+	}
+
+	return depthFirstFinish(g, func(*Vertex[T]) {}) == nil
+}
+
+// depthFirstFinish runs an iterative DFS over the whole graph, and calls the
+// finish function on each vertex once all of its descendants are finished.
+// It stops and returns ErrDAGHasCycle as soon as it finds an edge to a
+// vertex that is still on the DFS path, which means there is a cycle.
+func depthFirstFinish[T comparable](g Graph[T], finish func(v *Vertex[T])) error {
+	const (
+		white = iota // not visited yet
+		gray         // on the current DFS path
+		black        // finished
+	)
+
+	type frame struct {
+		vertex    *Vertex[T]
+		neighbors []*Vertex[T]
+		next      int // the index of the next neighbor to visit
+	}
+
+	colors := make(map[T]int, g.Order())
+	return g.ForEachVertex(func(root *Vertex[T]) error {
+		if colors[root.label] != white {
+			return nil
+		}
+
+		colors[root.label] = gray
+		stack := []frame{{vertex: root, neighbors: root.adjacent()}}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.next == len(top.neighbors) {
+				colors[top.vertex.label] = black
+				finish(top.vertex)
+				stack = stack[:len(stack)-1]
+				continue
+			}
+
+			neighbor := top.neighbors[top.next]
+			top.next++
+
+			switch colors[neighbor.label] {
+			case gray:
+				return ErrDAGHasCycle
+			case white:
+				colors[neighbor.label] = gray
+				stack = append(stack, frame{vertex: neighbor, neighbors: neighbor.adjacent()})
+			}
+		}
+
+		return nil
+	})
+}
+
 // StableTopologySort does the same as TopologySort, but it takes a function
 // for comparing tied vertices. This is useful when you want to
 // have a stable sort order for vertices with multiple topological orderings.
 func StableTopologySort[T comparable](g Graph[T], cmp func(a, b T) bool) ([]*Vertex[T], error) {
-	// Initialize a map to store the inDegree of each vertex
-	inDegrees := make(map[*Vertex[T]]int)
 	vertices := g.GetAllVertices()
-	for _, v := range vertices {
-		inDegrees[v] = v.inDegree
-	}
+	// Initialize a map to store the inDegree of each vertex
+	inDegrees := inDegreesOf(vertices)
 
 	// Initialize the sorted list of vertices
 	sortedVertices := make([]*Vertex[T], 0, len(vertices))
@@ -88,7 +176,7 @@ func StableTopologySort[T comparable](g Graph[T], cmp func(a, b T) bool) ([]*Ver
 
 		// Collect neighbors whose in-degree becomes zero after removing current vertex
 		var newZeroInDegree []*Vertex[T]
-		for _, neighbor := range curr.neighbors {
+		for _, neighbor := range curr.adjacent() {
 			inDegrees[neighbor]--
 			if inDegrees[neighbor] == 0 {
 				newZeroInDegree = append(newZeroInDegree, neighbor)
@@ -107,8 +195,314 @@ func StableTopologySort[T comparable](g Graph[T], cmp func(a, b T) bool) ([]*Ver
 	return sortedVertices, nil
 }
 
-func sortVerticesWithCmp[T comparable](vertices []*Vertex[T], cmp func(a, b T) bool) {
-	sort.Slice(vertices, func(i, j int) bool {
+// TopologicalSortWithSecondary does the same as StableTopologySort, but it
+// breaks the ties between the ready vertices with two comparators: the
+// vertices are compared by the primary one, and the ones that are equal
+// by the primary one, i.e., neither is less than the other, are compared by
+// the secondary one. The dependencies always come first, so the comparators
+// only order the vertices that are ready at the same time. If the
+// comparators define a total order, the result is fully deterministic.
+//
+// It returns ErrDAGHasCycle if it finds a cycle in the graph.
+func TopologicalSortWithSecondary[T comparable](g Graph[T], primary, secondary func(a, b T) bool) ([]*Vertex[T], error) {
+	return StableTopologySort(g, func(a, b T) bool {
+		if primary(a, b) {
+			return true
+		}
+		if primary(b, a) {
+			return false
+		}
+		return secondary(a, b)
+	})
+}
+
+// PriorityTopologySort does the same as TopologySort, but among the vertices
+// that are ready to be processed, it always picks the one with the highest
+// priority. Vertices with equal priorities are picked in the order they
+// became ready, and the initial sources in the order of their formatted
+// labels, so the order is deterministic.
+//
+// Unlike StableTopologySort, which sorts the whole ready set after each step,
+// it keeps the ready vertices in a max heap, so the time complexity is
+// O((V+E)logV).
+//
+// It returns error if it finds a cycle in the graph.
+func PriorityTopologySort[T comparable](g Graph[T], priority func(T) float64) ([]*Vertex[T], error) {
+	vertices := sortVerticesWithCmp(g.GetAllVertices(), func(a, b T) bool {
+		return fmt.Sprint(a) < fmt.Sprint(b)
+	})
+	// Initialize a map to store the inDegree of each vertex
+	inDegrees := inDegreesOf(vertices)
+
+	// Initialize the heap with vertices of inDegrees zero
+	ready := &priorityVertexHeap[T]{}
+	for _, v := range vertices {
+		if inDegrees[v] == 0 {
+			ready.push(v, priority(v.label))
+		}
+	}
+
+	sortedVertices := make([]*Vertex[T], 0, len(vertices))
+	for ready.Len() > 0 {
+		// Get the ready vertex with the highest priority
+		curr := ready.pop()
+		sortedVertices = append(sortedVertices, curr)
+
+		// Decrement the inDegree of each of the vertex's neighbors
+		for _, neighbor := range curr.adjacent() {
+			inDegrees[neighbor]--
+			if inDegrees[neighbor] == 0 {
+				ready.push(neighbor, priority(neighbor.label))
+			}
+		}
+	}
+
+	// If the sorted list does not contain all vertices, there is a cycle
+	if len(sortedVertices) != len(vertices) {
+		return nil, ErrDAGHasCycle
+	}
+
+	return sortedVertices, nil
+}
+
+// GroupedTopologySort does the same as TopologySort, but among the vertices
+// that are ready to be processed, it always picks one of the lowest group,
+// e.g., the earliest phase of a build. It is PriorityTopologySort with the
+// negated groups as the priorities, so the ties are broken the same way and
+// the order is deterministic.
+//
+// The groups only order the ready vertices, so the dependencies still win:
+// if a vertex of a lower group depends on a vertex of a higher group, the
+// latter comes first.
+//
+// It returns ErrDAGHasCycle if it finds a cycle in the graph.
+func GroupedTopologySort[T comparable](g Graph[T], group func(T) int) ([]*Vertex[T], error) {
+	// the lowest group has the highest priority
+	return PriorityTopologySort(g, func(label T) float64 {
+		return -float64(group(label))
+	})
+}
+
+// DAGDepth returns the number of topological generations of the graph,
+// which is the number of vertices in the longest path. It is the minimum
+// number of rounds needed to process all the vertices, if the vertices of
+// each round only depend on the vertices of the previous rounds.
+//
+// The depth of an empty graph is zero.
+//
+// It returns error if it finds a cycle in the graph.
+func DAGDepth[T comparable](g Graph[T]) (int, error) {
+	vertices := g.GetAllVertices()
+	inDegrees := inDegreesOf(vertices)
+
+	// the first generation contains the vertices of inDegree zero
+	var generation []*Vertex[T]
+	for v, inDegree := range inDegrees {
+		if inDegree == 0 {
+			generation = append(generation, v)
+		}
+	}
+
+	var depth, visited int
+	for len(generation) > 0 {
+		depth++
+		visited += len(generation)
+
+		var next []*Vertex[T]
+		for _, curr := range generation {
+			for _, neighbor := range curr.adjacent() {
+				inDegrees[neighbor]--
+				if inDegrees[neighbor] == 0 {
+					next = append(next, neighbor)
+				}
+			}
+		}
+
+		generation = next
+	}
+
+	// If not all vertices are visited, there is a cycle
+	if visited != len(vertices) {
+		return 0, ErrDAGHasCycle
+	}
+
+	return depth, nil
+}
+
+// TopologicalRanks returns the rank of each vertex, which is the length of the
+// longest path from any source vertex to it. The sources have rank zero, and
+// each vertex is ranked after all of its predecessors, so the vertices with
+// the same rank form a layer that can be processed together. The number of
+// distinct ranks is equal to the DAGDepth of the graph.
+//
+// It returns ErrDAGHasCycle if it finds a cycle in the graph.
+func TopologicalRanks[T comparable](g Graph[T]) (map[T]int, error) {
+	sortedVertices, err := TopologySort(g)
+	if err != nil {
+		return nil, err
+	}
+
+	// The predecessors of each vertex are ranked before it
+	ranks := make(map[T]int, len(sortedVertices))
+	for _, v := range sortedVertices {
+		ranks[v.label] = max(ranks[v.label], 0)
+		for _, neighbor := range v.adjacent() {
+			ranks[neighbor.label] = max(ranks[neighbor.label], ranks[v.label]+1)
+		}
+	}
+
+	return ranks, nil
+}
+
+// priorityVertex is an item of the priorityVertexHeap.
+type priorityVertex[T comparable] struct {
+	vertex   *Vertex[T]
+	priority float64
+	seq      int // the insertion sequence, that breaks the ties.
+}
+
+// priorityVertexHeap is a max heap of vertices ordered by their priority.
+// It implements the heap.Interface.
+type priorityVertexHeap[T comparable] struct {
+	items []priorityVertex[T]
+	seq   int
+}
+
+func (h *priorityVertexHeap[T]) push(v *Vertex[T], priority float64) {
+	heap.Push(h, priorityVertex[T]{vertex: v, priority: priority, seq: h.seq})
+	h.seq++
+}
+
+func (h *priorityVertexHeap[T]) pop() *Vertex[T] {
+	item, _ := heap.Pop(h).(priorityVertex[T])
+	return item.vertex
+}
+
+// Len is the number of elements in the heap.
+func (h *priorityVertexHeap[T]) Len() int { return len(h.items) }
+
+// Less reports whether the element with index i must be popped before
+// the element with index j.
+func (h *priorityVertexHeap[T]) Less(i, j int) bool {
+	if h.items[i].priority != h.items[j].priority {
+		return h.items[i].priority > h.items[j].priority
+	}
+	return h.items[i].seq < h.items[j].seq
+}
+
+// Swap swaps the elements with indexes i and j.
+func (h *priorityVertexHeap[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+// Push adds new item to the heap.
+func (h *priorityVertexHeap[T]) Push(x interface{}) {
+	if item, ok := x.(priorityVertex[T]); ok {
+		h.items = append(h.items, item)
+	}
+}
+
+// Pop removes and returns the last item of the underlying slice.
+func (h *priorityVertexHeap[T]) Pop() interface{} {
+	n := len(h.items)
+	item := h.items[n-1]
+	h.items = h.items[:n-1]
+	return item
+}
+
+// sortVerticesWithCmp sorts the vertices in place by their labels with the
+// specified less function, and returns them. The sort is stable, so the
+// vertices with equal labels keep their order.
+func sortVerticesWithCmp[T comparable](vertices []*Vertex[T], cmp func(a, b T) bool) []*Vertex[T] {
+	sort.SliceStable(vertices, func(i, j int) bool {
 		return cmp(vertices[i].label, vertices[j].label)
 	})
+
+	return vertices
+}
+
+// TopologySortSubset performs a topological sort of the vertices with the
+// specified labels only. The precedence between two selected vertices is
+// derived from the whole graph, so if 'a' reaches 'b' through vertices that
+// are not part of the subset, 'a' still comes before 'b' in the result.
+//
+// The result is deterministic for a given graph and order of the input labels.
+//
+// It returns ErrVertexDoesNotExist if any of the labels doesn't exist, and
+// ErrDAGHasCycle if the induced precedence between the selected vertices
+// contains a cycle.
+func TopologySortSubset[T comparable](g Graph[T], labels []T) ([]*Vertex[T], error) {
+	// Collect the selected vertices and ignore duplicate labels
+	selected := make(map[T]bool, len(labels))
+	subset := make([]*Vertex[T], 0, len(labels))
+	for _, label := range labels {
+		if selected[label] {
+			continue
+		}
+
+		v := g.GetVertexByID(label)
+		if v == nil {
+			return nil, ErrVertexDoesNotExist
+		}
+
+		selected[label] = true
+		subset = append(subset, v)
+	}
+
+	// Build the induced precedence by finding the selected vertices that
+	// are reachable from each selected vertex.
+	successors := make(map[T][]*Vertex[T], len(subset))
+	inDegrees := make(map[T]int, len(subset))
+	for _, v := range subset {
+		visited := make(map[T]bool)
+		stack := append([]*Vertex[T](nil), v.adjacent()...)
+		for len(stack) > 0 {
+			curr := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if visited[curr.label] {
+				continue
+			}
+			visited[curr.label] = true
+
+			// a selected vertex that reaches itself is part of a cycle
+			if curr.label == v.label {
+				return nil, ErrDAGHasCycle
+			}
+
+			if selected[curr.label] {
+				successors[v.label] = append(successors[v.label], curr)
+				inDegrees[curr.label]++
+			}
+
+			stack = append(stack, curr.adjacent()...)
+		}
+	}
+
+	// Initialize a queue with the selected vertices of inDegrees zero
+	queue := make([]*Vertex[T], 0, len(subset))
+	for _, v := range subset {
+		if inDegrees[v.label] == 0 {
+			queue = append(queue, v)
+		}
+	}
+
+	sortedVertices := make([]*Vertex[T], 0, len(subset))
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+
+		sortedVertices = append(sortedVertices, curr)
+
+		for _, successor := range successors[curr.label] {
+			inDegrees[successor.label]--
+			if inDegrees[successor.label] == 0 {
+				queue = append(queue, successor)
+			}
+		}
+	}
+
+	if len(sortedVertices) != len(subset) {
+		return nil, ErrDAGHasCycle
+	}
+
+	return sortedVertices, nil
 }
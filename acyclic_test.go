@@ -1,7 +1,9 @@
 package gograph
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -74,6 +76,149 @@ func TestTopologySort(t *testing.T) {
 	}
 }
 
+func TestTopologySortDFS(t *testing.T) {
+	g := New[int](Directed())
+	edges := [][2]int{{1, 2}, {2, 3}, {2, 4}, {2, 5}, {3, 5}, {4, 6}, {5, 6}, {7, 4}}
+	for _, e := range edges {
+		_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+	}
+	g.AddVertexByLabel(8)
+
+	sortedVertices, err := TopologySortDFS(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(sortedVertices) != 8 {
+		t.Fatalf(testErrMsgWrongLen, 8, len(sortedVertices))
+	}
+
+	position := make(map[int]int, len(sortedVertices))
+	for i, v := range sortedVertices {
+		position[v.Label()] = i
+	}
+
+	for _, e := range edges {
+		if position[e[0]] >= position[e[1]] {
+			t.Errorf("expected %d before %d, got order %v", e[0], e[1], extractLabels(sortedVertices))
+		}
+	}
+
+	// a long path doesn't overflow the stack
+	path := New[int](Directed())
+	for i := 1; i < 100000; i++ {
+		_, _ = path.AddEdge(NewVertex(i-1), NewVertex(i))
+	}
+
+	sortedVertices, err = TopologySortDFS(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i, v := range sortedVertices {
+		if v.Label() != i {
+			t.Fatalf(testErrMsgNotEqual, i, v.Label())
+		}
+	}
+}
+
+func TestTopologySortDFSCycle(t *testing.T) {
+	tests := [][][2]int{
+		{{1, 2}, {2, 3}, {3, 1}},
+		{{1, 2}, {2, 3}, {3, 4}, {4, 2}, {1, 5}},
+		{{1, 1}},
+	}
+
+	for _, edges := range tests {
+		g := New[int](Directed())
+		for _, e := range edges {
+			_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+		}
+
+		if _, err := TopologySortDFS(g); !errors.Is(err, ErrDAGHasCycle) {
+			t.Errorf("expected %v for %v, got %v", ErrDAGHasCycle, edges, err)
+		}
+	}
+
+	// a diamond has two paths to the same vertex, but no cycle
+	g := New[int](Directed())
+	for _, e := range [][2]int{{1, 2}, {1, 3}, {2, 4}, {3, 4}} {
+		_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+	}
+
+	if _, err := TopologySortDFS(g); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// rootCountingGraph counts the vertices visited by ForEachVertex.
+type rootCountingGraph[T comparable] struct {
+	Graph[T]
+	visited int
+}
+
+func (g *rootCountingGraph[T]) ForEachVertex(f func(v *Vertex[T]) error) error {
+	return g.Graph.ForEachVertex(func(v *Vertex[T]) error {
+		g.visited++
+		return f(v)
+	})
+}
+
+func TestIsDAG(t *testing.T) {
+	dag := New[int](Directed())
+	for i := 0; i < 100; i++ {
+		_, _ = dag.AddEdge(NewVertex(i), NewVertex(i+1))
+		_, _ = dag.AddEdge(NewVertex(i), NewVertex(i+2))
+	}
+
+	if !IsDAG(dag) {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	// closing the path makes a cycle
+	_, _ = dag.AddEdge(NewVertex(101), NewVertex(0))
+	if IsDAG(dag) {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	// an empty directed graph is a DAG, but an undirected graph isn't
+	if !IsDAG(New[int](Directed())) {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	undirected := New[int]()
+	_, _ = undirected.AddEdge(NewVertex(1), NewVertex(2))
+	if IsDAG(undirected) {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	// even without edges, an undirected graph isn't a DAG
+	edgeless := New[int]()
+	edgeless.AddVertexByLabel(1)
+	edgeless.AddVertexByLabel(2)
+	if IsDAG(edgeless) || IsDAG(New[int]()) {
+		t.Error(testErrMsgNotFalse)
+	}
+}
+
+func TestIsDAG_StopsAtFirstCycle(t *testing.T) {
+	// every vertex is on a 2-cycle, so the DFS from the first root finds one
+	g := New[int](Directed())
+	for i := 0; i < 1000; i += 2 {
+		_, _ = g.AddEdge(NewVertex(i), NewVertex(i+1))
+		_, _ = g.AddEdge(NewVertex(i+1), NewVertex(i))
+	}
+
+	counting := &rootCountingGraph[int]{Graph: g}
+	if IsDAG[int](counting) {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	if counting.visited != 1 {
+		t.Errorf(testErrMsgNotEqual, 1, counting.visited)
+	}
+}
+
 func TestStableTopologySort(t *testing.T) {
 	// Create a graph where multiple valid topological sorts are possible
 	g := New[int](Acyclic())
@@ -252,3 +397,308 @@ func extractLabels[T comparable](vertices []*Vertex[T]) []T {
 	}
 	return labels
 }
+
+func TestTopologySortSubset(t *testing.T) {
+	g := New[string](Acyclic())
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	vE := g.AddVertexByLabel("E")
+
+	// A -> B -> C -> D, E -> B
+	_, _ = g.AddEdge(vA, vB)
+	_, _ = g.AddEdge(vB, vC)
+	_, _ = g.AddEdge(vC, vD)
+	_, _ = g.AddEdge(vE, vB)
+
+	// D and A are only connected through excluded vertices
+	sortedVertices, err := TopologySortSubset(g, []string{"D", "A"})
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	expectedOrder := []*Vertex[string]{vA, vD}
+	if !reflect.DeepEqual(sortedVertices, expectedOrder) {
+		t.Errorf("unexpected sort order. Got %v, expected %v",
+			extractLabels(sortedVertices), extractLabels(expectedOrder))
+	}
+
+	sortedVertices, err = TopologySortSubset(g, []string{"C", "E", "A"})
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	expectedOrder = []*Vertex[string]{vE, vA, vC}
+	if !reflect.DeepEqual(sortedVertices, expectedOrder) {
+		t.Errorf("unexpected sort order. Got %v, expected %v",
+			extractLabels(sortedVertices), extractLabels(expectedOrder))
+	}
+
+	_, err = TopologySortSubset(g, []string{"A", "X"})
+	if !errors.Is(err, ErrVertexDoesNotExist) {
+		t.Errorf(testErrMsgNotEqual, ErrVertexDoesNotExist, err)
+	}
+}
+
+func TestTopologySortSubsetCycle(t *testing.T) {
+	g := New[int](Directed())
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+	v4 := g.AddVertexByLabel(4)
+
+	// 1 -> 2 -> 3 -> 1, 2 -> 4
+	_, _ = g.AddEdge(v1, v2)
+	_, _ = g.AddEdge(v2, v3)
+	_, _ = g.AddEdge(v3, v1)
+	_, _ = g.AddEdge(v2, v4)
+
+	// the cycle passes through the excluded vertices 2 and 3
+	_, err := TopologySortSubset(g, []int{1, 4})
+	if !errors.Is(err, ErrDAGHasCycle) {
+		t.Errorf(testErrMsgNotEqual, ErrDAGHasCycle, err)
+	}
+
+	// the cycle doesn't affect a subset that is outside of it
+	sortedVertices, err := TopologySortSubset(g, []int{4})
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	if len(sortedVertices) != 1 {
+		t.Errorf(testErrMsgWrongLen, 1, len(sortedVertices))
+	}
+}
+
+func TestTopologicalSortWithSecondary(t *testing.T) {
+	// build-a and build-b are ready first, test-a and test-b after them
+	g := New[string](Acyclic())
+	for _, e := range [][2]string{
+		{"build-b", "test-b"},
+		{"build-a", "test-a"},
+		{"build-a", "deploy"},
+		{"test-b", "deploy"},
+	} {
+		_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+	}
+
+	// the primary key is the kind of the step, so the two builds and the
+	// two tests tie, and the secondary key orders them by name
+	kind := func(label string) string {
+		kind, _, _ := strings.Cut(label, "-")
+		return kind
+	}
+	byKind := func(a, b string) bool { return kind(a) < kind(b) }
+	byName := func(a, b string) bool { return a < b }
+
+	sortedVertices, err := TopologicalSortWithSecondary(g, byKind, byName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"build-a", "build-b", "test-a", "test-b", "deploy"}
+	if got := extractLabels(sortedVertices); !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected sort order. Got %v, expected %v", got, expected)
+	}
+
+	// reversing the secondary key reorders the ties, so test-b is done
+	// first, and deploy becomes ready before test-a
+	sortedVertices, _ = TopologicalSortWithSecondary(g, byKind, func(a, b string) bool { return a > b })
+	expected = []string{"build-b", "build-a", "test-b", "deploy", "test-a"}
+	if got := extractLabels(sortedVertices); !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected sort order. Got %v, expected %v", got, expected)
+	}
+}
+
+func TestPriorityTopologySort(t *testing.T) {
+	g := New[string](Acyclic())
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	vE := g.AddVertexByLabel("E")
+
+	// A -> C, B -> C, B -> D, D -> E
+	_, _ = g.AddEdge(vA, vC)
+	_, _ = g.AddEdge(vB, vC)
+	_, _ = g.AddEdge(vB, vD)
+	_, _ = g.AddEdge(vD, vE)
+
+	priorities := map[string]float64{"A": 1, "B": 2, "C": 5, "D": 3, "E": 4}
+
+	sortedVertices, err := PriorityTopologySort(g, func(label string) float64 {
+		return priorities[label]
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// - B has the highest priority among the sources A and B
+	// - D (3) beats A (1), and then E (4) becomes ready and beats A
+	// - C becomes ready only after A
+	expectedOrder := []*Vertex[string]{vB, vD, vE, vA, vC}
+	if !reflect.DeepEqual(sortedVertices, expectedOrder) {
+		t.Errorf("unexpected sort order. Got %v, expected %v",
+			extractLabels(sortedVertices), extractLabels(expectedOrder))
+	}
+}
+
+func TestPriorityTopologySortEqualPriorities(t *testing.T) {
+	g := New[string](Acyclic())
+	for _, label := range []string{"D", "B", "E", "A", "C"} {
+		g.AddVertexByLabel(label)
+	}
+	_, _ = g.AddEdge(g.GetVertexByID("A"), g.GetVertexByID("E"))
+
+	// the sources with equal priorities are picked in label order, whatever
+	// the order of the vertices in the graph
+	expected := []string{"A", "B", "C", "D", "E"}
+	for i := 0; i < 20; i++ {
+		sortedVertices, err := PriorityTopologySort(g, func(string) float64 { return 0 })
+		if err != nil {
+			t.Fatalf(testErrMsgError, err)
+		}
+
+		if labels := extractLabels(sortedVertices); !reflect.DeepEqual(labels, expected) {
+			t.Fatalf(testErrMsgNotEqual, expected, labels)
+		}
+	}
+}
+
+func TestPriorityTopologySortCycle(t *testing.T) {
+	g := New[int](Directed())
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+
+	_, _ = g.AddEdge(v1, v2)
+	_, _ = g.AddEdge(v2, v1)
+
+	_, err := PriorityTopologySort(g, func(label int) float64 {
+		return float64(label)
+	})
+	if !errors.Is(err, ErrDAGHasCycle) {
+		t.Errorf("expected cycle error, got %v", err)
+	}
+}
+
+func TestGroupedTopologySort(t *testing.T) {
+	g := New[string](Acyclic())
+	for _, e := range [][2]string{
+		{"compile-a", "test-a"},
+		{"compile-b", "package"},
+		{"test-a", "package"},
+		{"gen", "compile-b"},
+	} {
+		_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+	}
+
+	// phase 0 compiles, and phase 1 generates, tests and packages
+	phases := map[string]int{"compile-a": 0, "compile-b": 0, "gen": 1, "test-a": 1, "package": 1}
+
+	sortedVertices, err := GroupedTopologySort(g, func(label string) int {
+		return phases[label]
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// - compile-a is the only ready vertex of phase 0
+	// - compile-b depends on gen of phase 1, so gen comes before it
+	// - compile-b jumps ahead of test-a, which became ready earlier
+	expectedOrder := []string{"compile-a", "gen", "compile-b", "test-a", "package"}
+	if !reflect.DeepEqual(extractLabels(sortedVertices), expectedOrder) {
+		t.Errorf("unexpected sort order. Got %v, expected %v", extractLabels(sortedVertices), expectedOrder)
+	}
+}
+
+func TestGroupedTopologySortCycle(t *testing.T) {
+	g := New[int](Directed())
+	_, _ = g.AddEdge(NewVertex(1), NewVertex(2))
+	_, _ = g.AddEdge(NewVertex(2), NewVertex(1))
+
+	_, err := GroupedTopologySort(g, func(label int) int {
+		return label
+	})
+	if !errors.Is(err, ErrDAGHasCycle) {
+		t.Errorf("expected cycle error, got %v", err)
+	}
+}
+
+func TestDAGDepth(t *testing.T) {
+	depth, err := DAGDepth(New[int](Acyclic()))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if depth != 0 {
+		t.Errorf(testErrMsgNotEqual, 0, depth)
+	}
+
+	// the DAG fixture of TestTopologySort, where the longest
+	// chain is 1 -> 2 -> 3 -> 5 -> 6
+	g := New[int](Acyclic())
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+	v4 := g.AddVertexByLabel(4)
+	v5 := g.AddVertexByLabel(5)
+	v6 := g.AddVertexByLabel(6)
+
+	_, _ = g.AddEdge(v1, v2)
+	_, _ = g.AddEdge(v2, v3)
+	_, _ = g.AddEdge(v2, v4)
+	_, _ = g.AddEdge(v2, v5)
+	_, _ = g.AddEdge(v3, v5)
+	_, _ = g.AddEdge(v4, v6)
+	_, _ = g.AddEdge(v5, v6)
+
+	depth, err = DAGDepth(g)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if depth != 5 {
+		t.Errorf(testErrMsgNotEqual, 5, depth)
+	}
+
+	// an isolated vertex doesn't change the depth
+	g.AddVertexByLabel(7)
+	depth, _ = DAGDepth(g)
+	if depth != 5 {
+		t.Errorf(testErrMsgNotEqual, 5, depth)
+	}
+
+	cyclic := New[int](Directed())
+	_, _ = cyclic.AddEdge(NewVertex(1), NewVertex(2))
+	_, _ = cyclic.AddEdge(cyclic.GetVertexByID(2), cyclic.GetVertexByID(1))
+
+	_, err = DAGDepth(cyclic)
+	if !errors.Is(err, ErrDAGHasCycle) {
+		t.Errorf("expected cycle error, got %v", err)
+	}
+}
+
+func TestTopologicalRanks(t *testing.T) {
+	// the DAG fixture of TestTopologySort, and an isolated vertex
+	g := New[int](Acyclic())
+	for _, e := range [][2]int{{1, 2}, {2, 3}, {2, 4}, {2, 5}, {3, 5}, {4, 6}, {5, 6}} {
+		_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+	}
+	g.AddVertexByLabel(7)
+
+	ranks, err := TopologicalRanks(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[int]int{1: 0, 2: 1, 3: 2, 4: 2, 5: 3, 6: 4, 7: 0}
+	if !reflect.DeepEqual(ranks, expected) {
+		t.Errorf(testErrMsgNotEqual, expected, ranks)
+	}
+
+	cyclic := New[int](Directed())
+	_, _ = cyclic.AddEdge(NewVertex(1), NewVertex(2))
+	_, _ = cyclic.AddEdge(NewVertex(2), NewVertex(1))
+	if _, err = TopologicalRanks(cyclic); !errors.Is(err, ErrDAGHasCycle) {
+		t.Errorf(testErrMsgNotEqual, ErrDAGHasCycle, err)
+	}
+}
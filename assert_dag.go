@@ -0,0 +1,80 @@
+package gograph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CycleError is returned by AssertDAG when a directed graph has cycles. It
+// lists the groups of vertices that form the cycles, which are the strongly
+// connected components with more than one vertex, or a single vertex with a
+// self-loop.
+//
+// It wraps ErrDAGHasCycle, so errors.Is(err, ErrDAGHasCycle) reports true.
+type CycleError[T comparable] struct {
+	// Components are the labels of the vertices of each cyclic group. Each
+	// group and the groups themselves are sorted by the fmt representation
+	// of the labels.
+	Components [][]T
+}
+
+// Error lists the cyclic groups of vertices.
+func (e *CycleError[T]) Error() string {
+	groups := make([]string, len(e.Components))
+	for i, component := range e.Components {
+		labels := make([]string, len(component))
+		for j, label := range component {
+			labels[j] = fmt.Sprint(label)
+		}
+		groups[i] = "[" + strings.Join(labels, " ") + "]"
+	}
+
+	return fmt.Sprintf("%s: %d cyclic group(s): %s", ErrDAGHasCycle, len(groups), strings.Join(groups, ", "))
+}
+
+// Unwrap returns ErrDAGHasCycle.
+func (e *CycleError[T]) Unwrap() error {
+	return ErrDAGHasCycle
+}
+
+// AssertDAG validates that the directed graph has no cycles, e.g. as an
+// invariant check after importing data into a graph. Unlike TopologySort it
+// doesn't compute an order, but if the graph has cycles, it returns a
+// CycleError that lists all the groups of vertices forming them, which are
+// found by Tarjan's algorithm in O(V+E).
+//
+// It returns nil if the graph is acyclic, and ErrNotDirected if the graph
+// is undirected.
+func AssertDAG[T comparable](g Graph[T]) error {
+	if !g.IsDirected() {
+		return ErrNotDirected
+	}
+
+	var cycles [][]T
+	for _, component := range stronglyConnectedComponents(g) {
+		if len(component) == 1 && !g.HasEdge(component[0].label, component[0].label) {
+			continue
+		}
+
+		labels := make([]T, len(component))
+		for i, v := range component {
+			labels[i] = v.label
+		}
+
+		sort.Slice(labels, func(i, j int) bool {
+			return fmt.Sprint(labels[i]) < fmt.Sprint(labels[j])
+		})
+		cycles = append(cycles, labels)
+	}
+
+	if len(cycles) == 0 {
+		return nil
+	}
+
+	sort.Slice(cycles, func(i, j int) bool {
+		return fmt.Sprint(cycles[i][0]) < fmt.Sprint(cycles[j][0])
+	})
+
+	return &CycleError[T]{Components: cycles}
+}
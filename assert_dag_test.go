@@ -0,0 +1,54 @@
+package gograph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAssertDAG(t *testing.T) {
+	g := New[string](Acyclic())
+	_, _ = g.AddEdge(NewVertex("A"), NewVertex("B"))
+	_, _ = g.AddEdge(NewVertex("B"), NewVertex("C"))
+	_, _ = g.AddEdge(NewVertex("A"), NewVertex("C"))
+
+	if err := AssertDAG(g); err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	if err := AssertDAG(New[string]()); !errors.Is(err, ErrNotDirected) {
+		t.Errorf(testErrMsgNotEqual, ErrNotDirected, err)
+	}
+}
+
+func TestAssertDAGCycles(t *testing.T) {
+	g := New[string](Directed())
+	_, _ = g.AddEdge(NewVertex("A"), NewVertex("B"))
+	_, _ = g.AddEdge(NewVertex("B"), NewVertex("C"))
+	_, _ = g.AddEdge(NewVertex("C"), NewVertex("A"))
+	_, _ = g.AddEdge(NewVertex("C"), NewVertex("D"))
+	_, _ = g.AddEdge(NewVertex("D"), NewVertex("E"))
+	_, _ = g.AddEdge(NewVertex("E"), NewVertex("D"))
+	_, _ = g.AddEdge(NewVertex("E"), NewVertex("F"))
+	_, _ = g.AddEdge(NewVertex("G"), NewVertex("G"))
+
+	err := AssertDAG(g)
+	if !errors.Is(err, ErrDAGHasCycle) {
+		t.Fatalf(testErrMsgNotEqual, ErrDAGHasCycle, err)
+	}
+
+	var cycleErr *CycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Expected a CycleError, got %T", err)
+	}
+
+	expected := [][]string{{"A", "B", "C"}, {"D", "E"}, {"G"}}
+	if !reflect.DeepEqual(cycleErr.Components, expected) {
+		t.Errorf(testErrMsgNotEqual, expected, cycleErr.Components)
+	}
+
+	message := "the graph contains a cycle: 3 cyclic group(s): [A B C], [D E], [G]"
+	if err.Error() != message {
+		t.Errorf(testErrMsgNotEqual, message, err.Error())
+	}
+}
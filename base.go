@@ -1,6 +1,9 @@
 package gograph
 
-import "sync/atomic"
+import (
+	"fmt"
+	"sync/atomic"
+)
 
 // baseGraph represents a basic implementation of Graph interface. It
 // supports multiple types of graph.
@@ -17,26 +20,43 @@ type baseGraph[T comparable] struct {
 	// map is the label of destination vertex.
 	edges map[T]map[T]*Edge[T]
 
+	// edgeIDs maps the IDs of the edges to the edges, in the direction they
+	// were added. lastEdgeID is the ID of the latest added edge.
+	edgeIDs    map[uint64]*Edge[T]
+	lastEdgeID uint64
+
 	properties GraphProperties
 
+	// canonicalizer maps the labels to the canonical labels of the vertices,
+	// if the graph has a user-defined label equality. Otherwise, it is nil.
+	canonicalizer *labelCanonicalizer[T]
+
 	verticesCount uint32
 	edgesCount    uint32
+
+	// version is incremented by each change of the vertices or edges, so
+	// the derived data like ReachabilityIndex can detect that it is stale.
+	version atomic.Uint64
 }
 
 func newBaseGraph[T comparable](properties GraphProperties) *baseGraph[T] {
 	return &baseGraph[T]{
-		vertices:   make(map[T]*Vertex[T]),
-		edges:      make(map[T]map[T]*Edge[T]),
-		properties: properties,
+		vertices:      make(map[T]*Vertex[T]),
+		edges:         make(map[T]map[T]*Edge[T]),
+		edgeIDs:       make(map[uint64]*Edge[T]),
+		properties:    properties,
+		canonicalizer: newLabelCanonicalizer[T](properties),
 	}
 }
 
 // addToEdgeMap creates a new edge struct and adds it to the edges map inside
 // the baseGraph struct. Note that it doesn't add the neighbor to the source vertex.
 //
-// It returns the created edge.
-func (g *baseGraph[T]) addToEdgeMap(from, to *Vertex[T], options ...EdgeOptionFunc) *Edge[T] {
+// It returns the created edge, which is registered by the given ID.
+func (g *baseGraph[T]) addToEdgeMap(id uint64, from, to *Vertex[T], options ...EdgeOptionFunc) *Edge[T] {
 	edge := NewEdge(from, to, options...)
+	edge.id = id
+	g.edgeIDs[id] = edge
 	if _, ok := g.edges[from.label]; !ok {
 		g.edges[from.label] = map[T]*Edge[T]{to.label: edge}
 	} else {
@@ -44,6 +64,7 @@ func (g *baseGraph[T]) addToEdgeMap(from, to *Vertex[T], options ...EdgeOptionFu
 	}
 
 	atomic.AddUint32(&g.edgesCount, 1)
+	g.version.Add(1)
 	return edge
 }
 
@@ -75,8 +96,12 @@ func (g *baseGraph[T]) AddEdge(from, to *Vertex[T], options ...EdgeOptionFunc) (
 		return nil, ErrEdgeAlreadyExists
 	}
 
-	from = g.vertices[from.label]
-	to = g.vertices[to.label]
+	from = g.findVertex(from.label)
+	to = g.findVertex(to.label)
+
+	if g.properties.noSelfLoops && from == to {
+		return nil, ErrSelfLoop
+	}
 
 	from.neighbors = append(from.neighbors, to)
 	to.inDegree++
@@ -94,57 +119,113 @@ func (g *baseGraph[T]) AddEdge(from, to *Vertex[T], options ...EdgeOptionFunc) (
 		}
 	}
 
+	// both directions of an undirected edge share the ID
+	g.lastEdgeID++
+	id := g.lastEdgeID
+
 	// add "from" to the "to" vertex neighbor slice, if graph is undirected.
 	if !g.properties.isDirected {
 		to.neighbors = append(to.neighbors, from)
 		from.inDegree++
 
-		g.addToEdgeMap(to, from, options...)
+		g.addToEdgeMap(id, to, from, options...)
+	}
+
+	return g.addToEdgeMap(id, from, to, options...), nil
+}
+
+// GetOrAddEdge returns the edge from the 'from' vertex to the 'to' vertex
+// if it already exists, and otherwise adds it like AddEdge. The boolean
+// reports whether the edge was created. The options are only applied to a
+// new edge.
+func (g *baseGraph[T]) GetOrAddEdge(from, to *Vertex[T], options ...EdgeOptionFunc) (*Edge[T], bool, error) {
+	if edge := g.GetEdge(from, to); edge != nil {
+		return edge, false, nil
+	}
+
+	edge, err := g.AddEdge(from, to, options...)
+	if err != nil {
+		return nil, false, err
 	}
 
-	return g.addToEdgeMap(from, to, options...), nil
+	return edge, true, nil
 }
 
 // AddVertexByLabel adds a new vertex with the given label to the graph.
 // Label of the vertex is a comparable type. This method also accepts the
 // vertex properties such as weight.
 //
-// If there is a vertex with the same label in the graph, returns nil.
-// Otherwise, returns the created vertex.
+// If there is a vertex with the same label in the graph, the outcome
+// depends on the graph's DuplicateVertexPolicy: by default the existing
+// vertex is returned, and nil is returned under the other policies. Use
+// TryAddVertex to get the error of DuplicateVertexError. Otherwise, returns
+// the created vertex.
 func (g *baseGraph[T]) AddVertexByLabel(label T, options ...VertexOptionFunc) *Vertex[T] {
+	v, _ := g.TryAddVertex(label, options...)
+	return v
+}
+
+// TryAddVertex adds a new vertex like AddVertexByLabel, but returns an
+// error wrapping ErrVertexAlreadyExists if the vertex already exists and
+// the graph's DuplicateVertexPolicy is DuplicateVertexError.
+func (g *baseGraph[T]) TryAddVertex(label T, options ...VertexOptionFunc) (*Vertex[T], error) {
+	if existing := g.findVertex(label); existing != nil {
+		return g.duplicateVertex(existing)
+	}
+
 	var properties VertexProperties
 	for _, option := range options {
 		option(&properties)
 	}
 
-	v := g.addVertex(&Vertex[T]{label: label, properties: properties})
-
-	return v
+	return g.addVertex(&Vertex[T]{label: label, properties: properties}), nil
 }
 
 // AddVertex adds the input vertex to the graph. It doesn't add
 // vertex to the graph if the input vertex label is already exists
 // in the graph.
 func (g *baseGraph[T]) AddVertex(v *Vertex[T]) {
-	if v == nil {
+	if v == nil || g.findVertex(v.label) != nil {
 		return
 	}
 
 	g.addVertex(v)
 }
 
+// duplicateVertex applies the graph's DuplicateVertexPolicy to an attempt
+// to add a vertex that already exists.
+func (g *baseGraph[T]) duplicateVertex(existing *Vertex[T]) (*Vertex[T], error) {
+	switch g.properties.duplicateVertexPolicy {
+	case DuplicateVertexError:
+		return nil, fmt.Errorf("%w: %v", ErrVertexAlreadyExists, existing.label)
+	case DuplicateVertexReject:
+		return nil, nil
+	default:
+		return existing, nil
+	}
+}
+
 func (g *baseGraph[T]) addVertex(v *Vertex[T]) *Vertex[T] {
-	if _, ok := g.vertices[v.label]; ok {
+	if g.findVertex(v.label) != nil {
 		return nil
 	}
 
+	if g.canonicalizer != nil {
+		g.canonicalizer.add(v.label)
+	}
+
 	g.vertices[v.label] = v
 	atomic.AddUint32(&g.verticesCount, 1)
+	g.version.Add(1)
 
 	return v
 }
 
 func (g *baseGraph[T]) findVertex(label T) *Vertex[T] {
+	if g.canonicalizer != nil {
+		label = g.canonicalizer.canonical(label)
+	}
+
 	return g.vertices[label]
 }
 
@@ -161,11 +242,13 @@ func (g *baseGraph[T]) GetAllEdges(from, to *Vertex[T]) []*Edge[T] {
 		return nil
 	}
 
-	if g.findVertex(from.label) == nil {
+	from = g.findVertex(from.label)
+	if from == nil {
 		return nil
 	}
 
-	if g.findVertex(to.label) == nil {
+	to = g.findVertex(to.label)
+	if to == nil {
 		return nil
 	}
 
@@ -201,11 +284,13 @@ func (g *baseGraph[T]) GetEdge(from, to *Vertex[T]) *Edge[T] {
 		return nil
 	}
 
-	if g.findVertex(from.label) == nil {
+	from = g.findVertex(from.label)
+	if from == nil {
 		return nil
 	}
 
-	if g.findVertex(to.label) == nil {
+	to = g.findVertex(to.label)
+	if to == nil {
 		return nil
 	}
 
@@ -216,6 +301,14 @@ func (g *baseGraph[T]) GetEdge(from, to *Vertex[T]) *Edge[T] {
 	return nil
 }
 
+// GetEdgeByID returns the edge with the specified ID, and reports whether
+// it exists. In undirected graph, returns the edge in the direction it
+// was added.
+func (g *baseGraph[T]) GetEdgeByID(id uint64) (*Edge[T], bool) {
+	edge, ok := g.edgeIDs[id]
+	return edge, ok
+}
+
 // EdgesOf returns a slice of all edges touching the specified vertex.
 // If no edges are touching the specified vertex returns an empty slice.
 //
@@ -226,7 +319,8 @@ func (g *baseGraph[T]) EdgesOf(v *Vertex[T]) []*Edge[T] {
 		return nil
 	}
 
-	if g.findVertex(v.label) == nil {
+	v = g.findVertex(v.label)
+	if v == nil {
 		return nil
 	}
 
@@ -273,18 +367,26 @@ func (g *baseGraph[T]) removeAllEdges(edge *Edge[T]) {
 		return
 	}
 
-	if edge.source == nil || g.findVertex(edge.source.label) == nil {
+	if edge.source == nil || edge.dest == nil {
 		return
 	}
 
-	if edge.dest == nil || g.findVertex(edge.dest.label) == nil {
+	source, dest := g.findVertex(edge.source.label), g.findVertex(edge.dest.label)
+	if source == nil || dest == nil {
 		return
 	}
 
-	g.removeEdge(edge)
+	// an undirected self-loop is stored once, but it is counted and listed
+	// as a neighbor twice, like the undirected edges.
+	if !g.IsDirected() && source == dest {
+		g.removeNeighbor(source.label, source.label)
+		atomic.AddUint32(&g.edgesCount, ^uint32(0))
+	}
 
-	if !g.IsDirected() {
-		g.removeEdge(NewEdge(edge.dest, edge.source))
+	g.removeEdge(NewEdge(source, dest))
+
+	if !g.IsDirected() && source != dest {
+		g.removeEdge(NewEdge(dest, source))
 	}
 }
 
@@ -292,6 +394,9 @@ func (g *baseGraph[T]) removeAllEdges(edge *Edge[T]) {
 // the internal map is zero, removes the source label from the edges.
 func (g *baseGraph[T]) removeEdge(edge *Edge[T]) {
 	if destMap, ok := g.edges[edge.source.label]; ok {
+		if existing, ok := destMap[edge.dest.label]; ok {
+			delete(g.edgeIDs, existing.id)
+		}
 		delete(destMap, edge.dest.label)
 
 		// remove the neighbor vertex from the source neighbors slice.
@@ -303,6 +408,7 @@ func (g *baseGraph[T]) removeEdge(edge *Edge[T]) {
 			delete(g.edges, edge.source.label)
 		}
 		atomic.AddUint32(&g.edgesCount, ^(uint32(1) - 1))
+		g.version.Add(1)
 	}
 }
 
@@ -357,6 +463,24 @@ func (g *baseGraph[T]) GetAllVertices() []*Vertex[T] {
 	return vertices
 }
 
+// GetAllVerticesSorted returns a slice of all existing vertices in the
+// graph, sorted by their labels with the specified less function.
+func (g *baseGraph[T]) GetAllVerticesSorted(less func(a, b T) bool) []*Vertex[T] {
+	return sortVerticesWithCmp(g.GetAllVertices(), less)
+}
+
+// ForEachVertex calls the callback function on each vertex of the graph, and
+// stops at the first error.
+func (g *baseGraph[T]) ForEachVertex(f func(v *Vertex[T]) error) error {
+	for _, vertex := range g.vertices {
+		if err := f(vertex); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // RemoveVertices removes all the specified vertices from this graph including
 // all its touching edges if present.
 func (g *baseGraph[T]) RemoveVertices(vertices ...*Vertex[T]) {
@@ -379,6 +503,20 @@ func (g *baseGraph[T]) removeVertex(in *Vertex[T]) {
 		for i := range v.neighbors {
 			v.neighbors[i].inDegree--
 		}
+
+		// the outgoing edges are dropped with the source label below. A
+		// self-loop is also an incoming edge, so it is removed and counted
+		// by the loop over the incoming edges instead.
+		var outgoing uint32
+		for dest, edge := range g.edges[v.label] {
+			if dest == v.label {
+				continue
+			}
+
+			delete(g.edgeIDs, edge.id)
+			outgoing++
+		}
+		atomic.AddUint32(&g.edgesCount, ^(outgoing - 1))
 	}
 
 	for sourceID := range g.edges {
@@ -390,7 +528,29 @@ func (g *baseGraph[T]) removeVertex(in *Vertex[T]) {
 
 	delete(g.edges, v.label)
 	delete(g.vertices, v.label)
+	if g.canonicalizer != nil {
+		g.canonicalizer.remove(v.label)
+	}
 	atomic.AddUint32(&g.verticesCount, ^(uint32(1) - 1))
+	g.version.Add(1)
+}
+
+// RemoveVerticesByLabel removes all the vertices with the specified labels
+// from this graph including all their touching edges. The removal is atomic,
+// if any of the labels doesn't exist, no vertex is removed and it returns
+// ErrVertexDoesNotExist.
+func (g *baseGraph[T]) RemoveVerticesByLabel(labels ...T) error {
+	for _, label := range labels {
+		if g.findVertex(label) == nil {
+			return ErrVertexDoesNotExist
+		}
+	}
+
+	for _, label := range labels {
+		g.removeVertex(g.findVertex(label))
+	}
+
+	return nil
 }
 
 // ContainsEdge returns 'true' if and only if this graph contains an edge
@@ -403,11 +563,13 @@ func (g *baseGraph[T]) ContainsEdge(from, to *Vertex[T]) bool {
 		return false
 	}
 
-	if g.findVertex(from.label) == nil {
+	from = g.findVertex(from.label)
+	if from == nil {
 		return false
 	}
 
-	if g.findVertex(to.label) == nil {
+	to = g.findVertex(to.label)
+	if to == nil {
 		return false
 	}
 
@@ -420,6 +582,32 @@ func (g *baseGraph[T]) ContainsEdge(from, to *Vertex[T]) bool {
 	return false
 }
 
+// HasEdge returns 'true' if there is an edge between the vertices with
+// the specified labels.
+//
+// In directed graph, it only checks the edge going from the 'from' vertex
+// to the 'to' vertex. In undirected graph, the order of the labels doesn't
+// matter.
+//
+// If any of the specified vertices does not exist in the graph, returns 'false'.
+func (g *baseGraph[T]) HasEdge(from, to T) bool {
+	if g.canonicalizer != nil {
+		from, to = g.canonicalizer.canonical(from), g.canonicalizer.canonical(to)
+	}
+
+	if _, ok := g.edges[from][to]; ok {
+		return true
+	}
+
+	if !g.IsDirected() {
+		if _, ok := g.edges[to][from]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ContainsVertex returns 'true' if this graph contains the specified vertex.
 //
 // If the specified vertex is nil, returns 'false'.
@@ -442,6 +630,20 @@ func (g *baseGraph[T]) AllEdges() []*Edge[T] {
 	return out
 }
 
+// ForEachEdge calls the callback function on each edge of the graph, and
+// stops at the first error.
+func (g *baseGraph[T]) ForEachEdge(f func(e *Edge[T]) error) error {
+	for _, dest := range g.edges {
+		for _, edge := range dest {
+			if err := f(edge); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Order returns the number of vertices in the graph.
 func (g *baseGraph[T]) Order() uint32 {
 	return atomic.LoadUint32(&g.verticesCount)
@@ -451,3 +653,9 @@ func (g *baseGraph[T]) Order() uint32 {
 func (g *baseGraph[T]) Size() uint32 {
 	return atomic.LoadUint32(&g.edgesCount)
 }
+
+// modifications returns the number of changes of the vertices and edges of
+// the graph so far.
+func (g *baseGraph[T]) modifications() uint64 {
+	return g.version.Load()
+}
@@ -34,6 +34,102 @@ func TestAddVertex(t *testing.T) {
 	}
 }
 
+func newDuplicateVertexGraph(policy DuplicateVertexPolicy) *baseGraph[string] {
+	g := newBaseGraph[string](newProperties(Directed(), WithDuplicateVertexPolicy(policy)))
+	_, _ = g.AddEdge(NewVertex("A"), NewVertex("B"))
+	_, _ = g.AddEdge(NewVertex("C"), NewVertex("B"))
+
+	return g
+}
+
+func assertDuplicateVertexUntouched(t *testing.T, g *baseGraph[string]) {
+	t.Helper()
+
+	if g.Order() != 3 || g.Size() != 2 {
+		t.Errorf("Expected 3 vertices and 2 edges, got %d and %d", g.Order(), g.Size())
+	}
+
+	b := g.GetVertexByID("B")
+	if b.InDegree() != 2 {
+		t.Errorf(testErrMsgNotEqual, 2, b.InDegree())
+	}
+
+	if b.Weight() != 0 {
+		t.Errorf(testErrMsgNotEqual, 0, b.Weight())
+	}
+
+	if len(g.GetVertexByID("A").neighbors) != 1 {
+		t.Errorf(testErrMsgWrongLen, 1, len(g.GetVertexByID("A").neighbors))
+	}
+}
+
+func TestAddVertexByLabel_DuplicateIdempotent(t *testing.T) {
+	g := newDuplicateVertexGraph(DuplicateVertexIdempotent)
+	b := g.GetVertexByID("B")
+
+	if v := g.AddVertexByLabel("B", WithVertexWeight(5)); v != b {
+		t.Errorf(testErrMsgNotEqual, b, v)
+	}
+
+	g.AddVertex(NewVertex("B"))
+	assertDuplicateVertexUntouched(t, g)
+
+	// the default policy is idempotent too
+	d := newBaseGraph[string](newProperties())
+	v := d.AddVertexByLabel("A")
+	if got := d.AddVertexByLabel("A"); got != v {
+		t.Errorf(testErrMsgNotEqual, v, got)
+	}
+}
+
+func TestAddVertexByLabel_DuplicateReject(t *testing.T) {
+	g := newDuplicateVertexGraph(DuplicateVertexReject)
+
+	if v := g.AddVertexByLabel("B", WithVertexWeight(5)); v != nil {
+		t.Errorf(testErrMsgNotEqual, nil, v)
+	}
+
+	if v := g.AddVertexByLabel("D"); v == nil {
+		t.Error("Expected the new vertex, but got nil")
+	}
+
+	if err := g.RemoveVerticesByLabel("D"); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+	assertDuplicateVertexUntouched(t, g)
+}
+
+func TestAddVertexByLabel_DuplicateError(t *testing.T) {
+	g := newDuplicateVertexGraph(DuplicateVertexError)
+
+	if v, err := g.TryAddVertex("B", WithVertexWeight(5)); !errors.Is(err, ErrVertexAlreadyExists) || v != nil {
+		t.Errorf(testErrMsgNotEqual, ErrVertexAlreadyExists, err)
+	}
+
+	if v := g.AddVertexByLabel("B", WithVertexWeight(5)); v != nil {
+		t.Errorf(testErrMsgNotEqual, nil, v)
+	}
+
+	g.AddVertex(NewVertex("B"))
+
+	// a new vertex is added without error
+	if v, err := g.TryAddVertex("D"); err != nil || v == nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if err := g.RemoveVerticesByLabel("D"); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	// adding edges between existing vertices doesn't count as a duplicate
+	if _, err := g.AddEdge(NewVertex("A"), NewVertex("C")); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	g.RemoveEdges(g.GetEdge(NewVertex("A"), NewVertex("C")))
+	assertDuplicateVertexUntouched(t, g)
+}
+
 func TestFindVertex(t *testing.T) {
 	g := newBaseGraph[string](newProperties(Directed()))
 	v1 := g.AddVertexByLabel("morocco")
@@ -233,6 +329,64 @@ func TestBaseGraph_AddEdgeWeighted(t *testing.T) {
 	}
 }
 
+func TestBaseGraph_GetOrAddEdge(t *testing.T) {
+	for _, directed := range []bool{true, false} {
+		var options []GraphOptionFunc
+		if directed {
+			options = append(options, Directed())
+		}
+		g := newBaseGraph[string](newProperties(append(options, Weighted())...))
+
+		edge, created, err := g.GetOrAddEdge(NewVertex("A"), NewVertex("B"), WithEdgeWeight(1))
+		if err != nil {
+			t.Fatalf(testErrMsgError, err)
+		}
+		if !created {
+			t.Error(testErrMsgNotTrue)
+		}
+
+		// the second call returns the existing edge, ignoring the options
+		again, created, err := g.GetOrAddEdge(NewVertex("A"), NewVertex("B"), WithEdgeWeight(2))
+		if err != nil {
+			t.Fatalf(testErrMsgError, err)
+		}
+		if created {
+			t.Error(testErrMsgNotFalse)
+		}
+		if again != edge || again.Weight() != 1 {
+			t.Errorf(testErrMsgNotEqual, edge, again)
+		}
+
+		// in undirected graph, the reverse direction is the same edge
+		if !directed {
+			reverse, created, _ := g.GetOrAddEdge(NewVertex("B"), NewVertex("A"))
+			if created || reverse.ID() != edge.ID() {
+				t.Errorf(testErrMsgNotEqual, edge.ID(), reverse.ID())
+			}
+		}
+
+		// the in-degree is incremented only once
+		if b := g.GetVertexByID("B"); b.InDegree() != 1 || len(g.GetVertexByID("A").neighbors) != 1 {
+			t.Errorf(testErrMsgNotEqual, 1, b.InDegree())
+		}
+
+		if expected := map[bool]uint32{true: 1, false: 2}[directed]; g.Size() != expected {
+			t.Errorf(testErrMsgNotEqual, expected, g.Size())
+		}
+	}
+
+	// the errors of AddEdge are returned
+	g := newBaseGraph[string](newProperties(Acyclic()))
+	_, _, _ = g.GetOrAddEdge(NewVertex("A"), NewVertex("B"))
+	if _, created, err := g.GetOrAddEdge(NewVertex("B"), NewVertex("A")); !errors.Is(err, ErrDAGCycle) || created {
+		t.Errorf(testErrMsgNotEqual, ErrDAGCycle, err)
+	}
+
+	if _, _, err := g.GetOrAddEdge(nil, NewVertex("A")); !errors.Is(err, ErrNilVertices) {
+		t.Errorf(testErrMsgNotEqual, ErrNilVertices, err)
+	}
+}
+
 func TestBaseGraph_EdgesOf(t *testing.T) {
 	g := newBaseGraph[int](newProperties(Directed()))
 	v1 := g.AddVertexByLabel(1)
@@ -490,6 +644,84 @@ func TestBaseGraph_RemoveVertices(t *testing.T) {
 	}
 }
 
+func TestBaseGraph_RemoveVerticesByLabel(t *testing.T) {
+	g := newBaseGraph[int](newProperties(Directed()))
+
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+	v4 := g.AddVertexByLabel(4)
+	_, _ = g.AddEdge(v1, v2)
+	_, _ = g.AddEdge(v2, v3)
+	_, _ = g.AddEdge(v3, v4)
+	_, _ = g.AddEdge(v1, v4)
+
+	// one invalid label must prevent the whole batch
+	err := g.RemoveVerticesByLabel(2, 3, 5)
+	if !errors.Is(err, ErrVertexDoesNotExist) {
+		t.Errorf(testErrMsgNotEqual, ErrVertexDoesNotExist, err)
+	}
+
+	if g.Order() != 4 {
+		t.Errorf(testErrMsgNotEqual, 4, g.Order())
+	}
+	if g.Size() != 4 {
+		t.Errorf(testErrMsgNotEqual, 4, g.Size())
+	}
+	if !g.ContainsEdge(v2, v3) {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	err = g.RemoveVerticesByLabel(2, 3)
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	if g.Order() != 2 {
+		t.Errorf(testErrMsgNotEqual, 2, g.Order())
+	}
+	if g.Size() != 1 {
+		t.Errorf(testErrMsgNotEqual, 1, g.Size())
+	}
+	if v1.OutDegree() != 1 {
+		t.Errorf(testErrMsgNotEqual, 1, v1.OutDegree())
+	}
+	if v4.InDegree() != 1 {
+		t.Errorf(testErrMsgNotEqual, 1, v4.InDegree())
+	}
+}
+
+func TestBaseGraph_RemoveVertexWithSelfLoop(t *testing.T) {
+	for _, options := range [][]GraphOptionFunc{{Directed()}, {}} {
+		g := newBaseGraph[int](newProperties(options...))
+		_, _ = g.AddEdge(NewVertex(1), NewVertex(1))
+		_, _ = g.AddEdge(NewVertex(1), NewVertex(2))
+		_, _ = g.AddEdge(NewVertex(3), NewVertex(1))
+		_, _ = g.AddEdge(NewVertex(2), NewVertex(3))
+
+		if err := g.RemoveVerticesByLabel(1); err != nil {
+			t.Fatalf(testErrMsgError, err)
+		}
+
+		// only the edge between 2 and 3 is left
+		expected := map[bool]uint32{true: 1, false: 2}[g.IsDirected()]
+		if g.Size() != expected {
+			t.Errorf(testErrMsgNotEqual, expected, g.Size())
+		}
+
+		if len(g.edgeIDs) != 1 {
+			t.Errorf(testErrMsgWrongLen, 1, len(g.edgeIDs))
+		}
+
+		// removing a self-loop edge restores the size
+		_, _ = g.AddEdge(NewVertex(2), NewVertex(2))
+		g.RemoveEdges(g.GetEdge(NewVertex(2), NewVertex(2)))
+		if g.Size() != expected || g.GetVertexByID(2).OutDegree() != 1 {
+			t.Errorf(testErrMsgNotEqual, expected, g.Size())
+		}
+	}
+}
+
 func TestBaseGraph_ContainsEdge(t *testing.T) {
 	g := newBaseGraph[int](newProperties(Directed()))
 
@@ -569,6 +801,40 @@ func TestBaseGraph_ContainsEdge(t *testing.T) {
 	}
 }
 
+func TestBaseGraph_HasEdge(t *testing.T) {
+	directed := newBaseGraph[int](newProperties(Directed()))
+	_, _ = directed.AddEdge(NewVertex(1), NewVertex(2))
+
+	if !directed.HasEdge(1, 2) {
+		t.Error(testErrMsgNotTrue)
+	}
+	if directed.HasEdge(2, 1) {
+		t.Error(testErrMsgNotFalse)
+	}
+	if directed.HasEdge(1, 3) {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	undirected := newBaseGraph[int](newProperties())
+	_, _ = undirected.AddEdge(NewVertex(1), NewVertex(2))
+
+	if !undirected.HasEdge(1, 2) {
+		t.Error(testErrMsgNotTrue)
+	}
+	if !undirected.HasEdge(2, 1) {
+		t.Error(testErrMsgNotTrue)
+	}
+	if undirected.HasEdge(2, 3) {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	// removing the edge must remove it in both directions
+	undirected.RemoveEdges(undirected.GetEdge(undirected.GetVertexByID(2), undirected.GetVertexByID(1)))
+	if undirected.HasEdge(1, 2) || undirected.HasEdge(2, 1) {
+		t.Error(testErrMsgNotFalse)
+	}
+}
+
 func TestBaseGraph_ContainsVertex(t *testing.T) {
 	g := newBaseGraph[int](newProperties(Directed()))
 	v1 := g.AddVertexByLabel(1)
@@ -924,3 +1190,266 @@ func Test_baseGraph_Cyclic(t *testing.T) {
 		t.Errorf("expected error %s, but got %s", ErrDAGCycle, err)
 	}
 }
+
+// Test_baseGraph_AcyclicRejectsCyclicData checks that loading edge data that
+// contains a cycle into an acyclic graph fails on the closing edge, and that
+// the rejected edge leaves the graph a valid DAG.
+func Test_baseGraph_AcyclicRejectsCyclicData(t *testing.T) {
+	graph := New[string](Acyclic())
+
+	data := [][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"D", "B"}}
+
+	var err error
+	var loaded int
+	for _, pair := range data {
+		_, err = graph.AddEdge(NewVertex(pair[0]), NewVertex(pair[1]))
+		if err != nil {
+			break
+		}
+		loaded++
+	}
+
+	if !errors.Is(err, ErrDAGCycle) {
+		t.Fatalf("expected error %s, but got %v", ErrDAGCycle, err)
+	}
+
+	if loaded != 3 {
+		t.Errorf("expected %d loaded edges, but got %d", 3, loaded)
+	}
+
+	if graph.Size() != 3 {
+		t.Errorf("expected size %d, but got %d", 3, graph.Size())
+	}
+
+	if graph.GetVertexByID("B").InDegree() != 1 {
+		t.Errorf("expected in-degree %d, but got %d", 1, graph.GetVertexByID("B").InDegree())
+	}
+
+	if _, err = TopologySort(graph); err != nil {
+		t.Errorf("expected no error, but got %s", err)
+	}
+}
+
+func TestBaseGraph_GetAllVerticesSorted(t *testing.T) {
+	g := New[int](Directed())
+	for _, label := range []int{5, 3, 9, 1, 7, 2, 8, 4, 6} {
+		g.AddVertexByLabel(label)
+	}
+
+	expected := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	for i := 0; i < 10; i++ {
+		labels := extractLabels(g.GetAllVerticesSorted(func(a, b int) bool { return a < b }))
+		if !reflect.DeepEqual(labels, expected) {
+			t.Fatalf(testErrMsgNotEqual, expected, labels)
+		}
+	}
+
+	descending := extractLabels(g.GetAllVerticesSorted(func(a, b int) bool { return a > b }))
+	if !reflect.DeepEqual(descending, []int{9, 8, 7, 6, 5, 4, 3, 2, 1}) {
+		t.Errorf(testErrMsgNotEqual, []int{9, 8, 7, 6, 5, 4, 3, 2, 1}, descending)
+	}
+}
+
+func TestBaseGraph_ForEachVertex(t *testing.T) {
+	g := New[int]()
+	for i := 0; i < 10; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	seen := make(map[int]bool)
+	err := g.ForEachVertex(func(v *Vertex[int]) error {
+		seen[v.Label()] = true
+		return nil
+	})
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	if len(seen) != 10 {
+		t.Errorf(testErrMsgWrongLen, 10, len(seen))
+	}
+
+	errStop := errors.New("stop")
+	var visited int
+	err = g.ForEachVertex(func(v *Vertex[int]) error {
+		visited++
+		if visited == 3 {
+			return errStop
+		}
+		return nil
+	})
+
+	if !errors.Is(err, errStop) {
+		t.Errorf(testErrMsgNotEqual, errStop, err)
+	}
+
+	if visited != 3 {
+		t.Errorf(testErrMsgNotEqual, 3, visited)
+	}
+}
+
+func TestBaseGraph_ForEachEdge(t *testing.T) {
+	g := New[int]()
+	for i := 1; i < 5; i++ {
+		_, _ = g.AddEdge(NewVertex(i-1), NewVertex(i))
+	}
+
+	// undirected edges are visited in both directions
+	var count int
+	err := g.ForEachEdge(func(e *Edge[int]) error {
+		if !g.ContainsEdge(e.Source(), e.Destination()) {
+			t.Errorf("Unexpected edge %v -> %v", e.Source().Label(), e.Destination().Label())
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	if count != 8 {
+		t.Errorf(testErrMsgNotEqual, 8, count)
+	}
+
+	errStop := errors.New("stop")
+	count = 0
+	err = g.ForEachEdge(func(e *Edge[int]) error {
+		count++
+		return errStop
+	})
+
+	if !errors.Is(err, errStop) {
+		t.Errorf(testErrMsgNotEqual, errStop, err)
+	}
+
+	if count != 1 {
+		t.Errorf(testErrMsgNotEqual, 1, count)
+	}
+}
+
+func TestBaseGraph_GetEdgeByID(t *testing.T) {
+	g := New[int](Directed())
+	ids := make(map[uint64][2]int)
+	for i := 1; i < 6; i++ {
+		e, err := g.AddEdge(NewVertex(i-1), NewVertex(i))
+		if err != nil {
+			t.Fatalf(testErrMsgError, err)
+		}
+
+		if _, ok := ids[e.ID()]; ok || e.ID() == 0 {
+			t.Fatalf("Expected a unique non-zero ID, but got %d", e.ID())
+		}
+		ids[e.ID()] = [2]int{i - 1, i}
+	}
+
+	// unrelated mutations don't change the IDs of the remaining edges
+	g.RemoveEdges(g.GetEdge(g.GetVertexByID(0), g.GetVertexByID(1)))
+	_ = g.RemoveVerticesByLabel(5)
+	_, _ = g.AddEdge(NewVertex(6), NewVertex(7))
+
+	for id, pair := range ids {
+		edge, ok := g.GetEdgeByID(id)
+		if pair == [2]int{0, 1} || pair == [2]int{4, 5} {
+			if ok {
+				t.Errorf("Expected removed edge %v to be missing", pair)
+			}
+			continue
+		}
+
+		if !ok {
+			t.Fatalf("Expected edge %v with ID %d", pair, id)
+		}
+
+		if edge.ID() != id || edge.Source().Label() != pair[0] || edge.Destination().Label() != pair[1] {
+			t.Errorf(testErrMsgNotEqual, pair, [2]int{edge.Source().Label(), edge.Destination().Label()})
+		}
+	}
+
+	if e := g.GetEdge(g.GetVertexByID(6), g.GetVertexByID(7)); ids[e.ID()] != [2]int{} {
+		t.Errorf("Expected a new ID, but got the reused ID %d", e.ID())
+	}
+
+	// both directions of an undirected edge share the ID
+	u := New[string]()
+	e, _ := u.AddEdge(NewVertex("a"), NewVertex("b"))
+	reverse := u.GetEdge(u.GetVertexByID("b"), u.GetVertexByID("a"))
+	if reverse.ID() != e.ID() {
+		t.Errorf(testErrMsgNotEqual, e.ID(), reverse.ID())
+	}
+
+	if edge, ok := u.GetEdgeByID(e.ID()); !ok || edge != e {
+		t.Errorf(testErrMsgNotEqual, e, edge)
+	}
+
+	u.RemoveEdges(reverse)
+	if _, ok := u.GetEdgeByID(e.ID()); ok {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	if NewEdge(NewVertex("a"), NewVertex("b")).ID() != 0 {
+		t.Error("Expected a detached edge to have the ID 0")
+	}
+}
+
+func newBenchmarkGraph() Graph[int] {
+	g := New[int](Directed())
+	for i := 1; i < 1000; i++ {
+		_, _ = g.AddEdge(NewVertex(i-1), NewVertex(i))
+	}
+
+	return g
+}
+
+func BenchmarkBaseGraph_GetAllVertices(b *testing.B) {
+	g := newBenchmarkGraph()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var total int
+		for _, v := range g.GetAllVertices() {
+			total += v.OutDegree()
+		}
+	}
+}
+
+func BenchmarkBaseGraph_ForEachVertex(b *testing.B) {
+	g := newBenchmarkGraph()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var total int
+		_ = g.ForEachVertex(func(v *Vertex[int]) error {
+			total += v.OutDegree()
+			return nil
+		})
+	}
+}
+
+func BenchmarkBaseGraph_AllEdges(b *testing.B) {
+	g := newBenchmarkGraph()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var total float64
+		for _, e := range g.AllEdges() {
+			total += e.Weight()
+		}
+	}
+}
+
+func BenchmarkBaseGraph_ForEachEdge(b *testing.B) {
+	g := newBenchmarkGraph()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var total float64
+		_ = g.ForEachEdge(func(e *Edge[int]) error {
+			total += e.Weight()
+			return nil
+		})
+	}
+}
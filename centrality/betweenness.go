@@ -0,0 +1,110 @@
+package centrality
+
+import (
+	"github.com/gavinhailey/gograph"
+	"github.com/gavinhailey/gograph/util"
+)
+
+// WeightedBetweennessCentrality computes the betweenness centrality of each
+// vertex, which is the number of shortest paths between all pairs of other
+// vertices that pass through the vertex, where the length of a path is the
+// total weight of its edges. When there are multiple shortest paths between
+// a pair of vertices, each of them counts proportionally. In an unweighted
+// graph, every edge weighs 1, so it is the standard betweenness centrality.
+//
+// It uses the Brandes algorithm with Dijkstra's algorithm from each vertex,
+// so the time complexity is O(V*E*logV).
+//
+// In undirected graph, each pair of vertices is counted once.
+//
+// It returns ErrNegativeEdgeWeight if any edge has a negative weight.
+func WeightedBetweennessCentrality[T comparable](g gograph.Graph[T]) (map[T]float64, error) {
+	if g.IsWeighted() {
+		for _, edge := range g.AllEdges() {
+			if edge.Weight() < 0 {
+				return nil, ErrNegativeEdgeWeight
+			}
+		}
+	}
+
+	vertices := g.GetAllVertices()
+	scores := make(map[T]float64, len(vertices))
+	for _, v := range vertices {
+		scores[v.Label()] = 0
+	}
+
+	for _, s := range vertices {
+		accumulateWeightedBetweenness(g, s, scores)
+	}
+
+	// In undirected graph, each pair of vertices is visited from both of
+	// its ends.
+	if !g.IsDirected() {
+		for label := range scores {
+			scores[label] /= 2
+		}
+	}
+
+	return scores, nil
+}
+
+// accumulateWeightedBetweenness adds the dependencies of the source vertex
+// on each other vertex to the scores, using Dijkstra's algorithm from the
+// source vertex.
+func accumulateWeightedBetweenness[T comparable](
+	g gograph.Graph[T],
+	source *gograph.Vertex[T],
+	scores map[T]float64,
+) {
+	sigma := map[T]float64{source.Label(): 1}
+	dist := map[T]float64{source.Label(): 0}
+	predecessors := make(map[T][]*gograph.Vertex[T])
+	settled := make(map[T]bool)
+
+	// the settle order is the order of non-decreasing distance
+	var order []*gograph.Vertex[T]
+	pq := util.NewVertexPriorityQueue[T]()
+	pq.Push(util.NewVertexWithPriority(g.GetVertexByID(source.Label()), 0))
+	for pq.Len() > 0 {
+		curr := pq.Pop().Vertex()
+		if settled[curr.Label()] {
+			continue
+		}
+		settled[curr.Label()] = true
+		order = append(order, curr)
+
+		for _, neighbor := range curr.Neighbors() {
+			label := neighbor.Label()
+			if settled[label] {
+				continue
+			}
+
+			weight := 1.0
+			if g.IsWeighted() {
+				weight = g.GetEdge(curr, neighbor).Weight()
+			}
+
+			alt := dist[curr.Label()] + weight
+			if d, ok := dist[label]; !ok || alt < d {
+				dist[label] = alt
+				sigma[label] = sigma[curr.Label()]
+				predecessors[label] = []*gograph.Vertex[T]{curr}
+				pq.Push(util.NewVertexWithPriority(g.GetVertexByID(label), alt))
+			} else if alt == d {
+				sigma[label] += sigma[curr.Label()]
+				predecessors[label] = append(predecessors[label], curr)
+			}
+		}
+	}
+
+	// Propagate the dependencies back from the farthest vertices
+	delta := make(map[T]float64, len(order))
+	for i := len(order) - 1; i > 0; i-- {
+		w := order[i]
+		for _, v := range predecessors[w.Label()] {
+			delta[v.Label()] += sigma[v.Label()] / sigma[w.Label()] * (1 + delta[w.Label()])
+		}
+
+		scores[w.Label()] += delta[w.Label()]
+	}
+}
@@ -0,0 +1,87 @@
+package centrality
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// initDetourGraph creates the cycle A-B-C-Y-X-A, where the path through B is
+// the shortest in hops, but the path through X and Y is the cheapest.
+func initDetourGraph(options ...gograph.GraphOptionFunc) gograph.Graph[string] {
+	g := gograph.New[string](options...)
+	for _, e := range []struct {
+		from, to string
+		weight   float64
+	}{
+		{"A", "B", 10}, {"B", "C", 10},
+		{"A", "X", 1}, {"X", "Y", 1}, {"Y", "C", 1},
+	} {
+		_, _ = g.AddEdge(gograph.NewVertex(e.from), gograph.NewVertex(e.to), gograph.WithEdgeWeight(e.weight))
+	}
+
+	return g
+}
+
+func assertScores(t *testing.T, expected, scores map[string]float64) {
+	t.Helper()
+
+	if len(scores) != len(expected) {
+		t.Errorf("expected %d scores, got %d", len(expected), len(scores))
+	}
+
+	for label, score := range expected {
+		if math.Abs(scores[label]-score) > 1e-9 {
+			t.Errorf("expected score %v of %s, got %v", score, label, scores[label])
+		}
+	}
+}
+
+func TestWeightedBetweennessCentrality(t *testing.T) {
+	// In hops, each vertex is in the middle of one pair of the 5-cycle
+	scores, err := WeightedBetweennessCentrality(initDetourGraph())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertScores(t, map[string]float64{"A": 1, "B": 1, "C": 1, "X": 1, "Y": 1}, scores)
+
+	// By weight, no shortest path goes through B, while X and Y carry the
+	// paths from A to C.
+	scores, err = WeightedBetweennessCentrality(initDetourGraph(gograph.Weighted()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertScores(t, map[string]float64{"A": 1, "B": 0, "C": 1, "X": 2, "Y": 2}, scores)
+}
+
+func TestWeightedBetweennessCentralityTies(t *testing.T) {
+	// two equally cheap paths from S to T share the dependency
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	for _, e := range []struct {
+		from, to string
+		weight   float64
+	}{
+		{"S", "A", 1}, {"A", "T", 2},
+		{"S", "B", 2}, {"B", "T", 1},
+		{"S", "T", 5},
+	} {
+		_, _ = g.AddEdge(gograph.NewVertex(e.from), gograph.NewVertex(e.to), gograph.WithEdgeWeight(e.weight))
+	}
+
+	scores, err := WeightedBetweennessCentrality(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertScores(t, map[string]float64{"S": 0, "A": 0.5, "B": 0.5, "T": 0}, scores)
+}
+
+func TestWeightedBetweennessCentralityNegativeWeight(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"), gograph.WithEdgeWeight(-1))
+
+	if _, err := WeightedBetweennessCentrality(g); !errors.Is(err, ErrNegativeEdgeWeight) {
+		t.Errorf("expected error %v, got %v", ErrNegativeEdgeWeight, err)
+	}
+}
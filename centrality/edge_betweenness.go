@@ -0,0 +1,84 @@
+package centrality
+
+import (
+	"github.com/gavinhailey/gograph"
+)
+
+// EdgeBetweennessCentrality computes the betweenness centrality of each edge,
+// which is the number of shortest paths between all pairs of vertices that
+// pass through the edge. When there are multiple shortest paths between a
+// pair of vertices, each of them counts proportionally. The edges with high
+// betweenness are the bridges between communities, which makes it the core
+// of the Girvan-Newman community detection algorithm.
+//
+// It uses the Brandes algorithm with a BFS from each vertex, so the edge
+// weights are ignored and every edge counts as a single hop. The time
+// complexity is O(V*E).
+//
+// In undirected graph, each pair of vertices is counted once, and both
+// directions of an edge are mapped to the same value.
+func EdgeBetweennessCentrality[T comparable](g gograph.Graph[T]) (map[*gograph.Edge[T]]float64, error) {
+	scores := make(map[*gograph.Edge[T]]float64)
+	for _, edge := range g.AllEdges() {
+		scores[edge] = 0
+	}
+
+	for _, s := range g.GetAllVertices() {
+		accumulateEdgeBetweenness(g, s, scores)
+	}
+
+	// In undirected graph, each pair of vertices is visited from both of
+	// its ends.
+	if !g.IsDirected() {
+		for edge := range scores {
+			scores[edge] /= 2
+		}
+	}
+
+	return scores, nil
+}
+
+// accumulateEdgeBetweenness adds the dependencies of the source vertex on
+// each edge to the scores, using a BFS from the source vertex.
+func accumulateEdgeBetweenness[T comparable](
+	g gograph.Graph[T],
+	source *gograph.Vertex[T],
+	scores map[*gograph.Edge[T]]float64,
+) {
+	sigma := map[T]float64{source.Label(): 1}
+	dist := map[T]int{source.Label(): 0}
+	predecessors := make(map[T][]*gograph.Vertex[T])
+
+	// the BFS order is the order of non-decreasing distance
+	order := []*gograph.Vertex[T]{g.GetVertexByID(source.Label())}
+	for i := 0; i < len(order); i++ {
+		curr := order[i]
+		for _, neighbor := range curr.Neighbors() {
+			label := neighbor.Label()
+			if _, ok := dist[label]; !ok {
+				dist[label] = dist[curr.Label()] + 1
+				order = append(order, g.GetVertexByID(label))
+			}
+
+			if dist[label] == dist[curr.Label()]+1 {
+				sigma[label] += sigma[curr.Label()]
+				predecessors[label] = append(predecessors[label], curr)
+			}
+		}
+	}
+
+	// Propagate the dependencies back from the farthest vertices
+	delta := make(map[T]float64, len(order))
+	for i := len(order) - 1; i > 0; i-- {
+		w := order[i]
+		for _, v := range predecessors[w.Label()] {
+			c := sigma[v.Label()] / sigma[w.Label()] * (1 + delta[w.Label()])
+			delta[v.Label()] += c
+
+			scores[g.GetEdge(v, w)] += c
+			if !g.IsDirected() {
+				scores[g.GetEdge(w, v)] += c
+			}
+		}
+	}
+}
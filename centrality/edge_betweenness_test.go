@@ -0,0 +1,77 @@
+package centrality
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// initBarbellGraph creates two complete graphs of the specified size which
+// are connected by a single bridge edge between 0 and size.
+func initBarbellGraph(size int) gograph.Graph[int] {
+	g := gograph.New[int]()
+	for offset := 0; offset <= size; offset += size {
+		for i := offset; i < offset+size; i++ {
+			for j := i + 1; j < offset+size; j++ {
+				_, _ = g.AddEdge(gograph.NewVertex(i), gograph.NewVertex(j))
+			}
+		}
+	}
+
+	_, _ = g.AddEdge(gograph.NewVertex(0), gograph.NewVertex(size))
+
+	return g
+}
+
+func TestEdgeBetweennessCentrality(t *testing.T) {
+	g := initBarbellGraph(4)
+
+	scores, err := EdgeBetweennessCentrality(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(scores) != int(g.Size()) {
+		t.Fatalf("expected %d scores, got %d", g.Size(), len(scores))
+	}
+
+	// every shortest path between the two cliques passes the bridge
+	bridge := g.GetEdge(g.GetVertexByID(0), g.GetVertexByID(4))
+	if scores[bridge] != 16 {
+		t.Errorf("expected the bridge betweenness to be 16, got %v", scores[bridge])
+	}
+
+	reverse := g.GetEdge(g.GetVertexByID(4), g.GetVertexByID(0))
+	if scores[reverse] != scores[bridge] {
+		t.Errorf("expected both directions to have the same betweenness, got %v and %v",
+			scores[bridge], scores[reverse])
+	}
+
+	for edge, score := range scores {
+		if edge != bridge && edge != reverse && score >= scores[bridge] {
+			t.Errorf("expected edge %v-%v to have lower betweenness than the bridge, got %v",
+				edge.Source().Label(), edge.Destination().Label(), score)
+		}
+	}
+}
+
+func TestEdgeBetweennessCentralityDirected(t *testing.T) {
+	// 1 -> 2 -> 3, and 1 -> 4 -> 3 with two shortest paths from 1 to 3
+	g := gograph.New[int](gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	_, _ = g.AddEdge(gograph.NewVertex(2), gograph.NewVertex(3))
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(4))
+	_, _ = g.AddEdge(gograph.NewVertex(4), gograph.NewVertex(3))
+
+	scores, err := EdgeBetweennessCentrality(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the edge 1->2 is on the path 1->2 and half of the paths from 1 to 3
+	edge := g.GetEdge(g.GetVertexByID(1), g.GetVertexByID(2))
+	if math.Abs(scores[edge]-1.5) > 1e-9 {
+		t.Errorf("expected betweenness 1.5, got %v", scores[edge])
+	}
+}
@@ -0,0 +1,106 @@
+package centrality
+
+import (
+	"errors"
+	"math"
+
+	"github.com/gavinhailey/gograph"
+	"github.com/gavinhailey/gograph/path"
+)
+
+var (
+	// ErrInvalidDamping is returned when the damping factor is not in the
+	// range of [0, 1].
+	ErrInvalidDamping = errors.New("damping factor must be in the range of [0, 1]")
+
+	// ErrNegativeEdgeWeight is returned when a function requires all the
+	// edge weights to be non-negative.
+	ErrNegativeEdgeWeight = path.ErrNegativeEdgeWeight
+)
+
+// WeightedPageRank computes the PageRank of each vertex, where each vertex
+// distributes its rank to its out-neighbors in proportion to the weights of
+// the edges leading to them, instead of uniformly. In an unweighted graph,
+// every edge weighs 1, so it is the standard PageRank. In undirected graph,
+// each edge is followed in both directions.
+//
+// With the probability of the damping factor, the random surfer follows an
+// edge, and otherwise jumps to a uniformly random vertex. The vertices
+// without outgoing weight spread their rank uniformly over all the vertices.
+// The ranks sum to 1.
+//
+// It iterates until the L1 norm of the change of the ranks is less than the
+// tolerance, or for the maximum number of iterations. Each iteration takes
+// O(V+E) time.
+//
+// It returns ErrInvalidDamping if the damping factor is not in the range of
+// [0, 1], and ErrNegativeEdgeWeight if any edge has a negative weight.
+func WeightedPageRank[T comparable](
+	g gograph.Graph[T],
+	damping float64,
+	iterations int,
+	tolerance float64,
+) (map[T]float64, error) {
+	if damping < 0 || damping > 1 || math.IsNaN(damping) {
+		return nil, ErrInvalidDamping
+	}
+
+	vertices := g.GetAllVertices()
+	n := float64(len(vertices))
+	ranks := make(map[T]float64, len(vertices))
+	for _, v := range vertices {
+		ranks[v.Label()] = 1 / n
+	}
+
+	edges := g.AllEdges()
+	weight := func(e *gograph.Edge[T]) float64 {
+		if !g.IsWeighted() {
+			return 1
+		}
+		return e.Weight()
+	}
+
+	outWeights := make(map[T]float64, len(vertices))
+	for _, edge := range edges {
+		if weight(edge) < 0 {
+			return nil, ErrNegativeEdgeWeight
+		}
+
+		outWeights[edge.Source().Label()] += weight(edge)
+	}
+
+	for i := 0; i < iterations; i++ {
+		// the rank of the vertices without outgoing weight is spread evenly
+		var dangling float64
+		for _, v := range vertices {
+			if outWeights[v.Label()] == 0 {
+				dangling += ranks[v.Label()]
+			}
+		}
+
+		base := (1-damping)/n + damping*dangling/n
+		next := make(map[T]float64, len(vertices))
+		for _, v := range vertices {
+			next[v.Label()] = base
+		}
+
+		for _, edge := range edges {
+			source := edge.Source().Label()
+			if outWeights[source] > 0 {
+				next[edge.Destination().Label()] += damping * ranks[source] * weight(edge) / outWeights[source]
+			}
+		}
+
+		var change float64
+		for label, rank := range next {
+			change += math.Abs(rank - ranks[label])
+		}
+
+		ranks = next
+		if change < tolerance {
+			break
+		}
+	}
+
+	return ranks, nil
+}
@@ -0,0 +1,109 @@
+package centrality
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestWeightedPageRank(t *testing.T) {
+	// A links to B with a heavy edge and to C with a light one, and both of
+	// them link back to A.
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	for _, e := range []struct {
+		from, to string
+		weight   float64
+	}{{"A", "B", 9}, {"A", "C", 1}, {"B", "A", 1}, {"C", "A", 1}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e.from), gograph.NewVertex(e.to), gograph.WithEdgeWeight(e.weight))
+	}
+
+	ranks, err := WeightedPageRank(g, 0.85, 100, 1e-10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if ranks["B"] <= ranks["C"] {
+		t.Errorf("expected the heavy edge to transfer more rank, got B=%v and C=%v", ranks["B"], ranks["C"])
+	}
+
+	// B and C receive the rank of A in proportion of 9:1, plus the same
+	// random jump share
+	share := (1 - 0.85) / 3
+	if ratio := (ranks["B"] - share) / (ranks["C"] - share); math.Abs(ratio-9) > 1e-6 {
+		t.Errorf("expected the ratio of the transferred rank to be 9, got %v", ratio)
+	}
+
+	assertRanksSumToOne(t, ranks)
+}
+
+func TestWeightedPageRankUnweighted(t *testing.T) {
+	// in the unweighted graph A -> B, A -> C, B -> C, C -> A, the standard
+	// PageRank with the damping d satisfies:
+	//	A = (1-d)/3 + d*C
+	//	B = (1-d)/3 + d*A/2
+	//	C = (1-d)/3 + d*A/2 + d*B
+	g := gograph.New[string](gograph.Directed())
+	for _, e := range [][2]string{{"A", "B"}, {"A", "C"}, {"B", "C"}, {"C", "A"}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	const d = 0.85
+	ranks, err := WeightedPageRank(g, d, 200, 1e-12)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	jump := (1 - d) / 3
+	for label, expected := range map[string]float64{
+		"A": jump + d*ranks["C"],
+		"B": jump + d*ranks["A"]/2,
+		"C": jump + d*ranks["A"]/2 + d*ranks["B"],
+	} {
+		if math.Abs(ranks[label]-expected) > 1e-9 {
+			t.Errorf("expected rank of %s to be %v, got %v", label, expected, ranks[label])
+		}
+	}
+
+	assertRanksSumToOne(t, ranks)
+
+	// the weights of a weighted graph with equal weights don't matter
+	weighted := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	for _, e := range [][2]string{{"A", "B"}, {"A", "C"}, {"B", "C"}, {"C", "A"}} {
+		_, _ = weighted.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]), gograph.WithEdgeWeight(4))
+	}
+
+	weightedRanks, _ := WeightedPageRank(weighted, d, 200, 1e-12)
+	for label, rank := range ranks {
+		if math.Abs(weightedRanks[label]-rank) > 1e-9 {
+			t.Errorf("expected rank of %s to be %v, got %v", label, rank, weightedRanks[label])
+		}
+	}
+}
+
+func TestWeightedPageRankErrors(t *testing.T) {
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"), gograph.WithEdgeWeight(-1))
+
+	if _, err := WeightedPageRank(g, 1.5, 10, 0); !errors.Is(err, ErrInvalidDamping) {
+		t.Errorf("expected error %v, got %v", ErrInvalidDamping, err)
+	}
+
+	if _, err := WeightedPageRank(g, 0.85, 10, 0); !errors.Is(err, ErrNegativeEdgeWeight) {
+		t.Errorf("expected error %v, got %v", ErrNegativeEdgeWeight, err)
+	}
+}
+
+func assertRanksSumToOne(t *testing.T, ranks map[string]float64) {
+	t.Helper()
+
+	var sum float64
+	for _, rank := range ranks {
+		sum += rank
+	}
+
+	if math.Abs(sum-1) > 1e-9 {
+		t.Errorf("expected the ranks to sum to 1, got %v", sum)
+	}
+}
@@ -0,0 +1,74 @@
+package gograph
+
+// Clone returns a deep copy of the graph with the same properties, vertices
+// and edges. The vertex and edge weights and the edge costs are preserved,
+// and modifying the clone doesn't affect the input graph.
+func Clone[T comparable](g Graph[T]) Graph[T] {
+	return induced(g, nil)
+}
+
+// induced returns a deep copy of the subgraph induced by the vertices for
+// which keep returns true, with the same properties as the input graph. If
+// keep is nil, it copies the whole graph.
+func induced[T comparable](g Graph[T], keep func(label T) bool) *baseGraph[T] {
+	// The acyclic property is set after adding the edges, since the input
+	// graph doesn't have any cycle and checking each edge is expensive.
+	properties := propertiesOf(g)
+	properties.isAcyclic = false
+
+	clone := newBaseGraph[T](properties)
+	vertices := g.GetAllVertices()
+	for _, v := range vertices {
+		if keep == nil || keep(v.label) {
+			clone.AddVertexByLabel(v.label, WithVertexWeight(v.Weight()))
+		}
+	}
+
+	// undirected edges are stored in both directions, so adding one of
+	// them creates the other one.
+	for _, v := range vertices {
+		from := clone.vertices[v.label]
+		if from == nil {
+			continue
+		}
+
+		for _, neighbor := range v.adjacent() {
+			to := clone.vertices[neighbor.label]
+			if to == nil || clone.ContainsEdge(from, to) {
+				continue
+			}
+
+			var options []EdgeOptionFunc
+			if edge := g.GetEdge(v, neighbor); edge != nil {
+				options = append(options, WithEdgeCapacityCost(edge.Weight(), edge.Cost()))
+			}
+
+			_, _ = clone.AddEdge(from, to, options...)
+		}
+	}
+
+	clone.properties.isAcyclic = g.IsAcyclic()
+
+	return clone
+}
+
+// propertiesOf returns the properties of the graph, including the ones
+// without a getter in the Graph interface, such as the label equality and
+// the duplicate vertex policy. For the other implementations of the
+// interface, only the directed, weighted and acyclic properties are known.
+func propertiesOf[T comparable](g Graph[T]) GraphProperties {
+	switch graph := g.(type) {
+	case *baseGraph[T]:
+		return graph.properties
+	case *snapshotGraph[T]:
+		return graph.properties
+	case *filteredView[T]:
+		return propertiesOf(graph.graph)
+	default:
+		return GraphProperties{
+			isDirected: g.IsDirected(),
+			isWeighted: g.IsWeighted(),
+			isAcyclic:  g.IsAcyclic(),
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package gograph
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+	g := New[string](Acyclic(), Weighted())
+	vA := g.AddVertexByLabel("A", WithVertexWeight(1.5))
+	vB := g.AddVertexByLabel("B", WithVertexWeight(2))
+	vC := g.AddVertexByLabel("C")
+	_, _ = g.AddEdge(vA, vB, WithEdgeWeight(3))
+	_, _ = g.AddEdge(vB, vC, WithEdgeCapacityCost(4, 2))
+
+	clone := Clone(g)
+	if !clone.IsDirected() || !clone.IsAcyclic() || !clone.IsWeighted() {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	if clone.Order() != g.Order() || clone.Size() != g.Size() {
+		t.Errorf(testErrMsgNotEqual, []uint32{g.Order(), g.Size()}, []uint32{clone.Order(), clone.Size()})
+	}
+
+	for _, v := range g.GetAllVertices() {
+		cv := clone.GetVertexByID(v.Label())
+		if cv == nil || cv == v {
+			t.Fatalf("expected a copy of vertex %s", v.Label())
+		}
+
+		if cv.Weight() != v.Weight() {
+			t.Errorf(testErrMsgNotEqual, v.Weight(), cv.Weight())
+		}
+	}
+
+	edge := clone.GetEdge(clone.GetVertexByID("B"), clone.GetVertexByID("C"))
+	if edge == nil || edge.Weight() != 4 || edge.Cost() != 2 {
+		t.Errorf(testErrMsgNotEqual, []float64{4, 2}, edge)
+	}
+
+	// the clone is still acyclic
+	if _, err := clone.AddEdge(clone.GetVertexByID("C"), clone.GetVertexByID("A")); err == nil {
+		t.Error(testErrMsgNoError)
+	}
+
+	// modifying the clone doesn't affect the input graph
+	clone.GetVertexByID("A").SetVertexWeight(10)
+	_, _ = clone.AddEdge(clone.GetVertexByID("A"), clone.GetVertexByID("C"))
+	if vA.Weight() != 1.5 {
+		t.Errorf(testErrMsgNotEqual, 1.5, vA.Weight())
+	}
+
+	if g.HasEdge("A", "C") {
+		t.Error(testErrMsgNotFalse)
+	}
+}
+
+func TestCloneUndirected(t *testing.T) {
+	g := New[int]()
+	_, _ = g.AddEdge(NewVertex(1), NewVertex(2))
+	_, _ = g.AddEdge(NewVertex(2), NewVertex(3))
+
+	clone := Clone(g)
+	if clone.IsDirected() {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	if clone.Size() != g.Size() {
+		t.Errorf(testErrMsgNotEqual, g.Size(), clone.Size())
+	}
+
+	if !clone.HasEdge(3, 2) {
+		t.Error(testErrMsgNotTrue)
+	}
+}
+
+func TestCloneKeepsLabelEqualityAndDuplicatePolicy(t *testing.T) {
+	g := New[string](
+		Directed(),
+		WithLabelEquality(strings.EqualFold, caseInsensitiveHash),
+		WithDuplicateVertexPolicy(DuplicateVertexError),
+	)
+	_, _ = g.AddEdge(NewVertex("A"), NewVertex("B"))
+
+	for name, clone := range map[string]Graph[string]{
+		"graph":    Clone(g),
+		"snapshot": Clone(Snapshot(g)),
+		"view":     Clone(FilteredView[string](g, nil, nil)),
+	} {
+		if !clone.HasEdge("a", "b") || clone.GetVertexByID("b") == nil {
+			t.Errorf("%s: expected the clone to compare the labels case-insensitively", name)
+		}
+
+		if _, err := clone.TryAddVertex("a"); !errors.Is(err, ErrVertexAlreadyExists) {
+			t.Errorf("%s: "+testErrMsgNotEqual, name, ErrVertexAlreadyExists, err)
+		}
+	}
+}
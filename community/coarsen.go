@@ -0,0 +1,175 @@
+package community
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// ErrLabelCollision is returned when two different supernodes get the same
+// label, because the formatted labels of the vertices are ambiguous.
+var ErrLabelCollision = errors.New("supernode labels collide")
+
+// Coarsen computes a maximal matching of the graph and contracts each pair
+// of matched vertices into a supernode, which is the coarsening step of the
+// multilevel partitioning algorithms. Coarsening the result repeatedly
+// builds a hierarchy of smaller graphs, where each level has roughly half
+// the vertices of the previous one.
+//
+// The matching is greedy and prefers the heaviest edges, so the heavy edges
+// are hidden inside the supernodes. In a directed graph, the edges are
+// matched regardless of their direction. The vertices are visited in the
+// order of their formatted labels, so the result is deterministic.
+//
+// The supernode of a matched pair is labeled "a+b", and the supernode of an
+// unmatched vertex "a", where a and b are the formatted labels of the
+// vertices. It returns the coarse graph, which is weighted and has the same
+// direction as the input graph, and a map from each supernode label to its
+// vertices. The weight of an edge between two supernodes is the sum of the
+// weights of the edges between their vertices, where each edge of an
+// unweighted graph weighs 1. The edges inside a supernode are dropped, and
+// its weight is the sum of the weights of its vertices.
+//
+// It returns ErrLabelCollision if the formatted labels make two supernodes
+// indistinguishable.
+func Coarsen[T comparable](g gograph.Graph[T]) (gograph.Graph[string], map[string][]T, error) {
+	vertices := g.GetAllVertices()
+	names := make(map[T]string, len(vertices))
+	for _, v := range vertices {
+		names[v.Label()] = fmt.Sprint(v.Label())
+	}
+
+	sort.Slice(vertices, func(i, j int) bool {
+		return names[vertices[i].Label()] < names[vertices[j].Label()]
+	})
+
+	index := make(map[T]int, len(vertices))
+	for i, v := range vertices {
+		index[v.Label()] = i
+	}
+
+	// the weight of each edge, where undirected edges are counted once
+	weight := func(e *gograph.Edge[T]) float64 {
+		if !g.IsWeighted() {
+			return 1
+		}
+		return e.Weight()
+	}
+
+	var edges []*gograph.Edge[T]
+	seen := make(map[uint64]bool)
+	_ = g.ForEachEdge(func(e *gograph.Edge[T]) error {
+		if !g.IsDirected() && seen[e.ID()] {
+			return nil
+		}
+
+		seen[e.ID()] = true
+		edges = append(edges, e)
+		return nil
+	})
+
+	// the total weight between the neighbors, regardless of the direction
+	adjacency := make([]map[int]float64, len(vertices))
+	for i := range adjacency {
+		adjacency[i] = make(map[int]float64)
+	}
+
+	for _, e := range edges {
+		u, v := index[e.Source().Label()], index[e.Destination().Label()]
+		if u != v {
+			adjacency[u][v] += weight(e)
+			adjacency[v][u] += weight(e)
+		}
+	}
+
+	mate := make([]int, len(vertices))
+	for i := range mate {
+		mate[i] = -1
+	}
+
+	for u := range vertices {
+		if mate[u] != -1 {
+			continue
+		}
+
+		best := -1
+		for v, w := range adjacency[u] {
+			if mate[v] != -1 {
+				continue
+			}
+
+			if best == -1 || w > adjacency[u][best] || (w == adjacency[u][best] && v < best) {
+				best = v
+			}
+		}
+
+		if best == -1 {
+			mate[u] = u
+			continue
+		}
+
+		mate[u], mate[best] = best, u
+	}
+
+	options := []gograph.GraphOptionFunc{gograph.Weighted()}
+	if g.IsDirected() {
+		options = append(options, gograph.Directed())
+	}
+
+	coarse := gograph.New[string](options...)
+	members := make(map[string][]T)
+	super := make([]*gograph.Vertex[string], len(vertices))
+	for u, v := range vertices {
+		if super[u] != nil {
+			continue
+		}
+
+		label := names[v.Label()]
+		group := []T{v.Label()}
+		vertexWeight := v.Weight()
+		if m := mate[u]; m != u {
+			label += "+" + names[vertices[m].Label()]
+			group = append(group, vertices[m].Label())
+			vertexWeight += vertices[m].Weight()
+		}
+
+		if _, ok := members[label]; ok {
+			return nil, nil, ErrLabelCollision
+		}
+
+		members[label] = group
+		super[u] = coarse.AddVertexByLabel(label, gograph.WithVertexWeight(vertexWeight))
+		super[mate[u]] = super[u]
+	}
+
+	// sum the weights of the edges between each pair of supernodes, in the
+	// order of their first edge
+	type pair struct{ from, to *gograph.Vertex[string] }
+	var pairs []pair
+	totals := make(map[pair]float64)
+	for _, e := range edges {
+		p := pair{super[index[e.Source().Label()]], super[index[e.Destination().Label()]]}
+		if p.from == p.to {
+			continue
+		}
+
+		if !g.IsDirected() {
+			if _, ok := totals[pair{p.to, p.from}]; ok {
+				p = pair{p.to, p.from}
+			}
+		}
+
+		if _, ok := totals[p]; !ok {
+			pairs = append(pairs, p)
+		}
+		totals[p] += weight(e)
+	}
+
+	for _, p := range pairs {
+		_, _ = coarse.AddEdge(p.from, p.to, gograph.WithEdgeWeight(totals[p]))
+	}
+
+	return coarse, members, nil
+}
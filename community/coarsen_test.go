@@ -0,0 +1,135 @@
+package community
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestCoarsen(t *testing.T) {
+	// the weighted cycle 1-2-3-4-5-6-1, where the heavy edges 1-2, 3-4 and
+	// 5-6 are matched, and the chords 1-3 and 2-4 join the first two pairs
+	g := gograph.New[int](gograph.Weighted())
+	for _, e := range [][3]int{{1, 2, 5}, {2, 3, 1}, {3, 4, 5}, {4, 5, 1}, {5, 6, 5}, {6, 1, 1}, {1, 3, 2}, {2, 4, 3}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]), gograph.WithEdgeWeight(float64(e[2])))
+	}
+
+	coarse, members, err := Coarsen(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedMembers := map[string][]int{"1+2": {1, 2}, "3+4": {3, 4}, "5+6": {5, 6}}
+	if !reflect.DeepEqual(members, expectedMembers) {
+		t.Errorf("expected supernodes %v, got %v", expectedMembers, members)
+	}
+
+	if coarse.Order() != 3 || coarse.IsDirected() || !coarse.IsWeighted() {
+		t.Fatalf("expected an undirected weighted graph with 3 vertices, got %d", coarse.Order())
+	}
+
+	expectedWeights := map[[2]string]float64{{"1+2", "3+4"}: 6, {"3+4", "5+6"}: 1, {"5+6", "1+2"}: 1}
+	for pair, weight := range expectedWeights {
+		for _, p := range [][2]string{pair, {pair[1], pair[0]}} {
+			edge := coarse.GetEdge(coarse.GetVertexByID(p[0]), coarse.GetVertexByID(p[1]))
+			if edge == nil {
+				t.Fatalf("expected edge %v", p)
+			}
+
+			if edge.Weight() != weight {
+				t.Errorf("expected weight %v of %v, got %v", weight, p, edge.Weight())
+			}
+		}
+	}
+
+	if coarse.Size() != 6 {
+		t.Errorf("expected 3 undirected edges, got size %d", coarse.Size())
+	}
+}
+
+func TestCoarsenDirected(t *testing.T) {
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	g.AddVertexByLabel("a", gograph.WithVertexWeight(1))
+	g.AddVertexByLabel("b", gograph.WithVertexWeight(2))
+	g.AddVertexByLabel("c", gograph.WithVertexWeight(4))
+	for _, e := range []struct {
+		from, to string
+		weight   float64
+	}{{"a", "b", 2}, {"b", "a", 3}, {"a", "c", 1}, {"b", "c", 4}, {"c", "a", 2}} {
+		_, _ = g.AddEdge(g.GetVertexByID(e.from), g.GetVertexByID(e.to), gograph.WithEdgeWeight(e.weight))
+	}
+
+	coarse, members, err := Coarsen(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedMembers := map[string][]string{"a+b": {"a", "b"}, "c": {"c"}}
+	if !reflect.DeepEqual(members, expectedMembers) {
+		t.Errorf("expected supernodes %v, got %v", expectedMembers, members)
+	}
+
+	ab, c := coarse.GetVertexByID("a+b"), coarse.GetVertexByID("c")
+	if ab.Weight() != 3 || c.Weight() != 4 {
+		t.Errorf("expected vertex weights 3 and 4, got %v and %v", ab.Weight(), c.Weight())
+	}
+
+	if edge := coarse.GetEdge(ab, c); edge == nil || edge.Weight() != 5 {
+		t.Errorf("expected edge a+b -> c with weight 5, got %v", edge)
+	}
+
+	if edge := coarse.GetEdge(c, ab); edge == nil || edge.Weight() != 2 {
+		t.Errorf("expected edge c -> a+b with weight 2, got %v", edge)
+	}
+}
+
+func TestCoarsenHierarchy(t *testing.T) {
+	// the unweighted cycle of 16 vertices halves on each level
+	g := gograph.New[int]()
+	for i := 0; i < 16; i++ {
+		_, _ = g.AddEdge(gograph.NewVertex(i), gograph.NewVertex((i+1)%16))
+	}
+
+	coarse, members, err := Coarsen(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if coarse.Order() != 8 || len(members) != 8 {
+		t.Fatalf("expected 8 supernodes, got %d", coarse.Order())
+	}
+
+	var total float64
+	_ = coarse.ForEachEdge(func(e *gograph.Edge[string]) error {
+		total += e.Weight()
+		return nil
+	})
+
+	// 8 of the 16 edges are inside the supernodes, and the rest are
+	// counted in both directions
+	if total != 16 {
+		t.Errorf("expected total weight 16, got %v", total)
+	}
+
+	coarser, _, err := Coarsen(coarse)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if coarser.Order() != 4 {
+		t.Errorf("expected 4 supernodes, got %d", coarser.Order())
+	}
+}
+
+func TestCoarsenLabelCollision(t *testing.T) {
+	// "a+b" matched with "c" collides with "a" matched with "b+c"
+	g := gograph.New[string]()
+	_, _ = g.AddEdge(gograph.NewVertex("a"), gograph.NewVertex("b+c"))
+	_, _ = g.AddEdge(gograph.NewVertex("a+b"), gograph.NewVertex("c"))
+
+	if _, _, err := Coarsen(g); !errors.Is(err, ErrLabelCollision) {
+		t.Errorf("expected error %v, got %v", ErrLabelCollision, err)
+	}
+}
@@ -0,0 +1,99 @@
+package community
+
+import (
+	"errors"
+
+	"github.com/gavinhailey/gograph"
+	"github.com/gavinhailey/gograph/centrality"
+	"github.com/gavinhailey/gograph/connectivity"
+)
+
+var (
+	// ErrNotUndirected is returned when a function requires an undirected graph.
+	ErrNotUndirected = connectivity.ErrNotUndirected
+
+	// ErrInvalidCommunityCount is returned when the target number of
+	// communities is less than one or greater than the number of vertices.
+	ErrInvalidCommunityCount = errors.New("invalid number of communities")
+)
+
+// GirvanNewman detects the communities of an undirected graph by repeatedly
+// removing the edge with the highest edge betweenness, until the graph splits
+// into the target number of connected components. The edges between the
+// communities are expected to have the highest betweenness, since all the
+// shortest paths between the communities pass through them.
+//
+// It operates on a clone of the graph, so the input graph is not modified.
+// The returned communities contain the vertices of the input graph. If the
+// graph already has at least the target number of components, it returns
+// them without removing any edge.
+//
+// The ties of the betweenness are broken by removing the edge with the
+// lowest ID in the input graph, so the result is deterministic.
+//
+// Each iteration computes the edge betweenness of the whole graph, so the
+// time complexity is O(E^2*V).
+//
+// It returns ErrNotUndirected if the graph is directed, and
+// ErrInvalidCommunityCount if the target number of communities is less
+// than one or greater than the number of vertices.
+func GirvanNewman[T comparable](g gograph.Graph[T], targetCommunities int) ([][]*gograph.Vertex[T], error) {
+	if g.IsDirected() {
+		return nil, ErrNotUndirected
+	}
+
+	if targetCommunities < 1 || targetCommunities > int(g.Order()) {
+		return nil, ErrInvalidCommunityCount
+	}
+
+	clone := gograph.Clone(g)
+	components, err := connectivity.ConnectedComponents(clone)
+	if err != nil {
+		return nil, err
+	}
+
+	for len(components) < targetCommunities {
+		scores, err := centrality.EdgeBetweennessCentrality(clone)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			highest   *gograph.Edge[T]
+			highestID uint64
+		)
+		for edge, score := range scores {
+			id := originalEdgeID(g, edge)
+			if highest == nil || score > scores[highest] || score == scores[highest] && id < highestID {
+				highest, highestID = edge, id
+			}
+		}
+
+		clone.RemoveEdges(highest)
+		if components, err = connectivity.ConnectedComponents(clone); err != nil {
+			return nil, err
+		}
+	}
+
+	// Map the communities back to the vertices of the input graph
+	communities := make([][]*gograph.Vertex[T], len(components))
+	for i, component := range components {
+		for _, v := range component {
+			communities[i] = append(communities[i], g.GetVertexByID(v.Label()))
+		}
+	}
+
+	return communities, nil
+}
+
+// originalEdgeID returns the ID of the edge of the input graph that
+// corresponds to the edge of its clone, since the clone assigns its own IDs
+// in no particular order.
+func originalEdgeID[T comparable](g gograph.Graph[T], edge *gograph.Edge[T]) uint64 {
+	original := g.GetEdge(g.GetVertexByID(edge.Source().Label()), g.GetVertexByID(edge.Destination().Label()))
+	if original == nil {
+		return edge.ID()
+	}
+
+	return original.ID()
+}
@@ -0,0 +1,118 @@
+package community
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// initTwoClusterGraph creates two complete graphs {1, 2, 3, 4} and
+// {5, 6, 7, 8}, which are connected by the bridge edge 4-5.
+func initTwoClusterGraph() gograph.Graph[int] {
+	g := gograph.New[int]()
+	for _, cluster := range [][]int{{1, 2, 3, 4}, {5, 6, 7, 8}} {
+		for i := range cluster {
+			for j := i + 1; j < len(cluster); j++ {
+				_, _ = g.AddEdge(gograph.NewVertex(cluster[i]), gograph.NewVertex(cluster[j]))
+			}
+		}
+	}
+
+	_, _ = g.AddEdge(gograph.NewVertex(4), gograph.NewVertex(5))
+
+	return g
+}
+
+func sortedCommunities(communities [][]*gograph.Vertex[int]) [][]int {
+	out := make([][]int, 0, len(communities))
+	for _, community := range communities {
+		labels := make([]int, 0, len(community))
+		for _, v := range community {
+			labels = append(labels, v.Label())
+		}
+		sort.Ints(labels)
+		out = append(out, labels)
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i][0] < out[j][0] })
+
+	return out
+}
+
+func TestGirvanNewman(t *testing.T) {
+	g := initTwoClusterGraph()
+	size := g.Size()
+
+	communities, err := GirvanNewman(g, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := [][]int{{1, 2, 3, 4}, {5, 6, 7, 8}}
+	if got := sortedCommunities(communities); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected communities %v, got %v", expected, got)
+	}
+
+	// the communities contain the vertices of the input graph
+	if communities[0][0] != g.GetVertexByID(communities[0][0].Label()) {
+		t.Error("expected the vertices of the input graph")
+	}
+
+	// the input graph is untouched
+	if g.Size() != size || !g.HasEdge(4, 5) {
+		t.Error("expected the input graph to be unchanged")
+	}
+}
+
+func TestGirvanNewmanTiedScores(t *testing.T) {
+	// all the edges of a cycle have the same betweenness, so the first edge
+	// 0-1 is removed, and then the middle edge 3-4 of the remaining path
+	g := gograph.New[int]()
+	for i := 0; i < 6; i++ {
+		_, _ = g.AddEdge(gograph.NewVertex(i), gograph.NewVertex((i+1)%6))
+	}
+
+	expected := [][]int{{0, 4, 5}, {1, 2, 3}}
+	for i := 0; i < 20; i++ {
+		communities, err := GirvanNewman(g, 2)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if got := sortedCommunities(communities); !reflect.DeepEqual(got, expected) {
+			t.Fatalf("Expected %v, got %v", expected, got)
+		}
+	}
+}
+
+func TestGirvanNewmanSingleCommunity(t *testing.T) {
+	g := initTwoClusterGraph()
+
+	communities, err := GirvanNewman(g, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(communities) != 1 || len(communities[0]) != 8 {
+		t.Errorf("expected a single community of all vertices, got %v", sortedCommunities(communities))
+	}
+}
+
+func TestGirvanNewmanErrors(t *testing.T) {
+	g := initTwoClusterGraph()
+
+	for _, target := range []int{0, 9} {
+		if _, err := GirvanNewman(g, target); !errors.Is(err, ErrInvalidCommunityCount) {
+			t.Errorf("expected error %v for %d communities, got %v", ErrInvalidCommunityCount, target, err)
+		}
+	}
+
+	directed := gograph.New[int](gograph.Directed())
+	_, _ = directed.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	if _, err := GirvanNewman(directed, 1); !errors.Is(err, ErrNotUndirected) {
+		t.Errorf("expected error %v, got %v", ErrNotUndirected, err)
+	}
+}
@@ -0,0 +1,97 @@
+package connectivity
+
+import (
+	"errors"
+
+	"github.com/gavinhailey/gograph"
+	"github.com/gavinhailey/gograph/traverse"
+)
+
+var (
+	ErrNotDirected   = gograph.ErrNotDirected
+	ErrNotUndirected = errors.New("graph is not undirected")
+)
+
+// IsConnected reports whether the specified undirected graph is connected,
+// which means there is a path between every pair of its vertices, so the
+// graph has a single connected component.
+//
+// An empty graph and a graph with a single vertex are considered connected.
+//
+// It returns ErrNotUndirected if the graph is directed. Use IsStronglyConnected
+// for directed graphs.
+func IsConnected[T comparable](g gograph.Graph[T]) (bool, error) {
+	if g.IsDirected() {
+		return false, ErrNotUndirected
+	}
+
+	vertices := g.GetAllVertices()
+	if len(vertices) == 0 {
+		return true, nil
+	}
+
+	iter, err := traverse.NewBreadthFirstIterator(g, vertices[0].Label())
+	if err != nil {
+		return false, err
+	}
+
+	var visited int
+	_ = iter.Iterate(func(*gograph.Vertex[T]) error {
+		visited++
+		return nil
+	})
+
+	return visited == len(vertices), nil
+}
+
+// ConnectedComponents returns the vertices of each connected component of
+// the specified undirected graph, which are found by a breadth-first search
+// from each vertex that hasn't been visited yet, in O(V+E).
+//
+// It returns ErrNotUndirected if the graph is directed. Use Tarjan, Kosaraju
+// or Gabow for the strongly connected components of directed graphs.
+func ConnectedComponents[T comparable](g gograph.Graph[T]) ([][]*gograph.Vertex[T], error) {
+	if g.IsDirected() {
+		return nil, ErrNotUndirected
+	}
+
+	visited := make(map[T]bool)
+	var components [][]*gograph.Vertex[T]
+	for _, v := range g.GetAllVertices() {
+		if visited[v.Label()] {
+			continue
+		}
+
+		visited[v.Label()] = true
+		component := []*gograph.Vertex[T]{v}
+		for i := 0; i < len(component); i++ {
+			for _, neighbor := range component[i].Neighbors() {
+				if !visited[neighbor.Label()] {
+					visited[neighbor.Label()] = true
+					component = append(component, g.GetVertexByID(neighbor.Label()))
+				}
+			}
+		}
+
+		components = append(components, component)
+	}
+
+	return components, nil
+}
+
+// IsStronglyConnected reports whether the specified directed graph is
+// strongly connected, which means every vertex is reachable from every
+// other vertex, so the graph has a single strongly connected component.
+//
+// An empty graph and a graph with a single vertex are considered strongly
+// connected.
+//
+// It returns ErrNotDirected if the graph is undirected. Use IsConnected
+// for undirected graphs.
+func IsStronglyConnected[T comparable](g gograph.Graph[T]) (bool, error) {
+	if !g.IsDirected() {
+		return false, ErrNotDirected
+	}
+
+	return len(Tarjan(g)) <= 1, nil
+}
@@ -0,0 +1,134 @@
+package connectivity
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestIsConnected(t *testing.T) {
+	g := gograph.New[int]()
+
+	// an empty graph is connected
+	connected, err := IsConnected(g)
+	if err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+	if !connected {
+		t.Error("Expected empty graph to be connected")
+	}
+
+	// a single vertex graph is connected
+	v1 := g.AddVertexByLabel(1)
+	connected, _ = IsConnected(g)
+	if !connected {
+		t.Error("Expected single vertex graph to be connected")
+	}
+
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+	v4 := g.AddVertexByLabel(4)
+
+	_, _ = g.AddEdge(v1, v2)
+	_, _ = g.AddEdge(v3, v4)
+
+	connected, _ = IsConnected(g)
+	if connected {
+		t.Error("Expected graph with two components to be disconnected")
+	}
+
+	_, _ = g.AddEdge(v2, v3)
+
+	connected, _ = IsConnected(g)
+	if !connected {
+		t.Error("Expected graph to be connected")
+	}
+
+	_, err = IsConnected(gograph.New[int](gograph.Directed()))
+	if !errors.Is(err, ErrNotUndirected) {
+		t.Errorf("Expected error %s, got %v", ErrNotUndirected, err)
+	}
+}
+
+func TestIsStronglyConnected(t *testing.T) {
+	g := gograph.New[int](gograph.Directed())
+
+	// an empty graph is strongly connected
+	connected, err := IsStronglyConnected(g)
+	if err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+	if !connected {
+		t.Error("Expected empty graph to be strongly connected")
+	}
+
+	// a single vertex graph is strongly connected
+	v1 := g.AddVertexByLabel(1)
+	connected, _ = IsStronglyConnected(g)
+	if !connected {
+		t.Error("Expected single vertex graph to be strongly connected")
+	}
+
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+
+	_, _ = g.AddEdge(v1, v2)
+	_, _ = g.AddEdge(v2, v3)
+
+	connected, _ = IsStronglyConnected(g)
+	if connected {
+		t.Error("Expected path graph not to be strongly connected")
+	}
+
+	_, _ = g.AddEdge(v3, v1)
+
+	connected, _ = IsStronglyConnected(g)
+	if !connected {
+		t.Error("Expected cycle graph to be strongly connected")
+	}
+
+	_, err = IsStronglyConnected(gograph.New[int]())
+	if !errors.Is(err, ErrNotDirected) || !errors.Is(err, gograph.ErrNotDirected) {
+		t.Errorf("Expected error %s, got %v", ErrNotDirected, err)
+	}
+}
+
+func TestConnectedComponents(t *testing.T) {
+	g := gograph.New[int]()
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	_, _ = g.AddEdge(gograph.NewVertex(2), gograph.NewVertex(3))
+	_, _ = g.AddEdge(gograph.NewVertex(4), gograph.NewVertex(5))
+	g.AddVertexByLabel(6)
+
+	components, err := ConnectedComponents(g)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	var got [][]int
+	for _, component := range components {
+		var labels []int
+		for _, v := range component {
+			if v != g.GetVertexByID(v.Label()) {
+				t.Errorf("Expected the vertices of the graph, got a copy of %d", v.Label())
+			}
+			labels = append(labels, v.Label())
+		}
+		sort.Ints(labels)
+		got = append(got, labels)
+	}
+	sort.Slice(got, func(i, j int) bool { return got[i][0] < got[j][0] })
+
+	expected := [][]int{{1, 2, 3}, {4, 5}, {6}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected components %v, got %v", expected, got)
+	}
+
+	_, err = ConnectedComponents(gograph.New[int](gograph.Directed()))
+	if !errors.Is(err, ErrNotUndirected) {
+		t.Errorf("Expected error %s, got %v", ErrNotUndirected, err)
+	}
+}
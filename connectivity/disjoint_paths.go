@@ -0,0 +1,111 @@
+package connectivity
+
+import (
+	"math"
+
+	"github.com/gavinhailey/gograph"
+	"github.com/gavinhailey/gograph/flow"
+)
+
+// EdgeConnectivity returns the maximum number of edge-disjoint paths from
+// the source vertex to the sink vertex. By Menger's theorem, it is equal to
+// the minimum number of edges that must be removed to disconnect the sink
+// from the source.
+//
+// It computes the maximum flow in a copy of the graph where every edge has
+// a unit capacity, so the edge weights are ignored.
+//
+// It returns ErrVertexDoesNotExist if any of the vertices doesn't exist, and
+// flow.ErrSourceIsSink if the source and the sink are the same vertex.
+func EdgeConnectivity[T comparable](g gograph.Graph[T], source, sink T) (int, error) {
+	var options []gograph.GraphOptionFunc
+	if g.IsDirected() {
+		options = append(options, gograph.Directed())
+	}
+
+	unit := gograph.New[T](options...)
+	for _, v := range g.GetAllVertices() {
+		unit.AddVertexByLabel(v.Label())
+	}
+
+	for _, edge := range g.AllEdges() {
+		_, _ = unit.AddEdge(
+			unit.GetVertexByID(edge.Source().Label()),
+			unit.GetVertexByID(edge.Destination().Label()),
+		)
+	}
+
+	maxFlow, err := flow.MaxFlow(unit, source, sink)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(maxFlow), nil
+}
+
+// splitVertex is a vertex of the split graph that VertexConnectivity builds.
+// Each original vertex is split into an 'in' and an 'out' vertex.
+type splitVertex[T comparable] struct {
+	label T
+	out   bool
+}
+
+// VertexConnectivity returns the maximum number of internally vertex-disjoint
+// paths from the source vertex to the sink vertex. By Menger's theorem, it
+// is equal to the minimum number of vertices, other than the source and the
+// sink, that must be removed to disconnect the sink from the source.
+//
+// It splits every vertex into an 'in' and an 'out' vertex connected by an
+// edge with a unit capacity, and computes the maximum flow in the split graph.
+//
+// If there is an edge from the source to the sink, removing other vertices
+// can never disconnect them, and it returns -1.
+//
+// It returns ErrVertexDoesNotExist if any of the vertices doesn't exist, and
+// flow.ErrSourceIsSink if the source and the sink are the same vertex.
+func VertexConnectivity[T comparable](g gograph.Graph[T], source, sink T) (int, error) {
+	if g.GetVertexByID(source) == nil || g.GetVertexByID(sink) == nil {
+		return 0, gograph.ErrVertexDoesNotExist
+	}
+
+	if source == sink {
+		return 0, flow.ErrSourceIsSink
+	}
+
+	if g.HasEdge(source, sink) {
+		return -1, nil
+	}
+
+	split := gograph.New[splitVertex[T]](gograph.Directed(), gograph.Weighted())
+	for _, v := range g.GetAllVertices() {
+		capacity := 1.0
+		if v.Label() == source || v.Label() == sink {
+			capacity = math.Inf(1)
+		}
+
+		_, _ = split.AddEdge(
+			split.AddVertexByLabel(splitVertex[T]{label: v.Label()}),
+			split.AddVertexByLabel(splitVertex[T]{label: v.Label(), out: true}),
+			gograph.WithEdgeWeight(capacity),
+		)
+	}
+
+	for _, edge := range g.AllEdges() {
+		_, _ = split.AddEdge(
+			split.GetVertexByID(splitVertex[T]{label: edge.Source().Label(), out: true}),
+			split.GetVertexByID(splitVertex[T]{label: edge.Destination().Label()}),
+			gograph.WithEdgeWeight(math.Inf(1)),
+		)
+	}
+
+	maxFlow, err := flow.MaxFlow(
+		split,
+		splitVertex[T]{label: source, out: true},
+		splitVertex[T]{label: sink},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(maxFlow), nil
+}
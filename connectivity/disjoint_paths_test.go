@@ -0,0 +1,135 @@
+package connectivity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+	"github.com/gavinhailey/gograph/flow"
+)
+
+func TestEdgeConnectivity(t *testing.T) {
+	// two edge-disjoint paths from 1 to 4
+	//	1 -> 2 -> 4
+	//	1 -> 3 -> 4
+	g := gograph.New[int](gograph.Directed(), gograph.Weighted())
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+	v4 := g.AddVertexByLabel(4)
+
+	// weights must be ignored
+	_, _ = g.AddEdge(v1, v2, gograph.WithEdgeWeight(10))
+	_, _ = g.AddEdge(v2, v4, gograph.WithEdgeWeight(10))
+	_, _ = g.AddEdge(v1, v3, gograph.WithEdgeWeight(10))
+	_, _ = g.AddEdge(v3, v4, gograph.WithEdgeWeight(10))
+
+	paths, err := EdgeConnectivity(g, 1, 4)
+	if err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+	if paths != 2 {
+		t.Errorf("Expected 2 edge-disjoint paths, got %d", paths)
+	}
+
+	// both paths go through the bottleneck edge 4 -> 5
+	v5 := g.AddVertexByLabel(5)
+	_, _ = g.AddEdge(v4, v5)
+
+	paths, err = EdgeConnectivity(g, 1, 5)
+	if err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+	if paths != 1 {
+		t.Errorf("Expected 1 edge-disjoint path, got %d", paths)
+	}
+
+	paths, _ = EdgeConnectivity(g, 5, 1)
+	if paths != 0 {
+		t.Errorf("Expected 0 edge-disjoint paths, got %d", paths)
+	}
+
+	_, err = EdgeConnectivity(g, 1, 6)
+	if !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %s, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	_, err = EdgeConnectivity(g, 1, 1)
+	if !errors.Is(err, flow.ErrSourceIsSink) {
+		t.Errorf("Expected error %s, got %v", flow.ErrSourceIsSink, err)
+	}
+}
+
+func TestEdgeConnectivityUndirected(t *testing.T) {
+	// a cycle has two edge-disjoint paths between any pair of vertices
+	g := gograph.New[int]()
+	for i := 0; i < 5; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 0; i < 5; i++ {
+		_, _ = g.AddEdge(g.GetVertexByID(i), g.GetVertexByID((i+1)%5))
+	}
+
+	paths, err := EdgeConnectivity(g, 0, 2)
+	if err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+	if paths != 2 {
+		t.Errorf("Expected 2 edge-disjoint paths, got %d", paths)
+	}
+}
+
+func TestVertexConnectivity(t *testing.T) {
+	// 3 is a cut vertex between {1, 2} and {4, 5}
+	//	1 - 2
+	//	 \ /
+	//	  3
+	//	 / \
+	//	4 - 5
+	g := gograph.New[int]()
+	for i := 1; i <= 5; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	edges := [][2]int{{1, 2}, {1, 3}, {2, 3}, {3, 4}, {3, 5}, {4, 5}}
+	for _, e := range edges {
+		_, _ = g.AddEdge(g.GetVertexByID(e[0]), g.GetVertexByID(e[1]))
+	}
+
+	paths, err := VertexConnectivity(g, 1, 5)
+	if err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+	if paths != 1 {
+		t.Errorf("Expected 1 vertex-disjoint path, got %d", paths)
+	}
+
+	// 1 and 2 are adjacent, so they can't be disconnected by removing vertices
+	paths, err = VertexConnectivity(g, 1, 2)
+	if err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+	if paths != -1 {
+		t.Errorf("Expected -1 for adjacent vertices, got %d", paths)
+	}
+
+	// an extra path around the cut vertex
+	_, _ = g.AddEdge(g.GetVertexByID(2), g.AddVertexByLabel(6))
+	_, _ = g.AddEdge(g.GetVertexByID(6), g.GetVertexByID(4))
+
+	paths, _ = VertexConnectivity(g, 1, 5)
+	if paths != 2 {
+		t.Errorf("Expected 2 vertex-disjoint paths, got %d", paths)
+	}
+
+	_, err = VertexConnectivity(g, 1, 7)
+	if !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %s, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	_, err = VertexConnectivity(g, 1, 1)
+	if !errors.Is(err, flow.ErrSourceIsSink) {
+		t.Errorf("Expected error %s, got %v", flow.ErrSourceIsSink, err)
+	}
+}
@@ -0,0 +1,45 @@
+package connectivity
+
+import (
+	"github.com/gavinhailey/gograph"
+)
+
+// SameSCC reports whether the vertices with labels 'a' and 'b' belong to
+// the same strongly connected component, which means 'a' reaches 'b' and
+// 'b' reaches 'a'. It is cheaper than computing all the components, since
+// it runs two BFS traversals that stop as soon as they reach the target.
+//
+// In undirected graph, it reports whether the vertices are connected.
+// A vertex is always in the same component as itself.
+//
+// It returns ErrVertexDoesNotExist if any of the vertices doesn't exist.
+func SameSCC[T comparable](g gograph.Graph[T], a, b T) (bool, error) {
+	va := g.GetVertexByID(a)
+	vb := g.GetVertexByID(b)
+	if va == nil || vb == nil {
+		return false, gograph.ErrVertexDoesNotExist
+	}
+
+	return reaches(g, va, b) && reaches(g, vb, a), nil
+}
+
+// reaches reports whether there is a path from the start vertex to the
+// vertex with the target label.
+func reaches[T comparable](g gograph.Graph[T], start *gograph.Vertex[T], target T) bool {
+	visited := map[T]bool{start.Label(): true}
+	queue := []*gograph.Vertex[T]{start}
+	for i := 0; i < len(queue); i++ {
+		if queue[i].Label() == target {
+			return true
+		}
+
+		for _, neighbor := range queue[i].Neighbors() {
+			if !visited[neighbor.Label()] {
+				visited[neighbor.Label()] = true
+				queue = append(queue, g.GetVertexByID(neighbor.Label()))
+			}
+		}
+	}
+
+	return false
+}
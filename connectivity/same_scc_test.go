@@ -0,0 +1,45 @@
+package connectivity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestSameSCC(t *testing.T) {
+	// 1 -> 2 -> 3 -> 1 form a cycle, 3 -> 4 -> 5 -> 4, and 6 is isolated
+	g := gograph.New[int](gograph.Directed())
+	for _, e := range [][2]int{{1, 2}, {2, 3}, {3, 1}, {3, 4}, {4, 5}, {5, 4}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+	g.AddVertexByLabel(6)
+
+	tests := []struct {
+		a, b     int
+		expected bool
+	}{
+		{1, 3, true},
+		{3, 2, true},
+		{4, 5, true},
+		{1, 4, false},
+		{5, 1, false},
+		{1, 6, false},
+		{6, 6, true},
+	}
+
+	for _, tt := range tests {
+		got, err := SameSCC(g, tt.a, tt.b)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got != tt.expected {
+			t.Errorf("expected SameSCC(%d, %d) to be %v, got %v", tt.a, tt.b, tt.expected, got)
+		}
+	}
+
+	if _, err := SameSCC(g, 1, 7); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %v, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+}
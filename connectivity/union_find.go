@@ -0,0 +1,101 @@
+package connectivity
+
+// UnionFindComponents tracks the connected components of an undirected
+// graph whose edges arrive one at a time. It is a disjoint-set forest with
+// union by rank and path compression, so every operation runs in
+// near-constant amortized time, and connectivity can be answered online
+// without recomputing the components of the whole graph after each edge.
+//
+// Vertices are added implicitly by Union, or explicitly by Add. Edge
+// removal is not supported.
+type UnionFindComponents[T comparable] struct {
+	parent     map[T]T
+	rank       map[T]int
+	components int
+}
+
+// NewUnionFindComponents creates an empty UnionFindComponents.
+func NewUnionFindComponents[T comparable]() *UnionFindComponents[T] {
+	return &UnionFindComponents[T]{
+		parent: make(map[T]T),
+		rank:   make(map[T]int),
+	}
+}
+
+// Add adds the specified vertex as a component of its own. It does nothing
+// if the vertex is already known.
+func (u *UnionFindComponents[T]) Add(label T) {
+	if _, ok := u.parent[label]; ok {
+		return
+	}
+
+	u.parent[label] = label
+	u.components++
+}
+
+// Union records an edge between a and b, merging their components. The
+// vertices are added first if they are not known yet. It reports whether
+// the edge merged two distinct components.
+func (u *UnionFindComponents[T]) Union(a, b T) bool {
+	u.Add(a)
+	u.Add(b)
+
+	rootA, rootB := u.find(a), u.find(b)
+	if rootA == rootB {
+		return false
+	}
+
+	switch {
+	case u.rank[rootA] < u.rank[rootB]:
+		u.parent[rootA] = rootB
+	case u.rank[rootA] > u.rank[rootB]:
+		u.parent[rootB] = rootA
+	default:
+		u.parent[rootB] = rootA
+		u.rank[rootA]++
+	}
+
+	u.components--
+	return true
+}
+
+// Connected reports whether a and b are in the same component. A vertex is
+// always connected to itself, and a vertex that is not known is connected
+// to nothing else.
+func (u *UnionFindComponents[T]) Connected(a, b T) bool {
+	if a == b {
+		return true
+	}
+
+	if _, ok := u.parent[a]; !ok {
+		return false
+	}
+
+	if _, ok := u.parent[b]; !ok {
+		return false
+	}
+
+	return u.find(a) == u.find(b)
+}
+
+// ComponentCount returns the number of components among the known vertices.
+func (u *UnionFindComponents[T]) ComponentCount() int {
+	return u.components
+}
+
+// find returns the root of the component of the specified known vertex,
+// compressing the path along the way.
+func (u *UnionFindComponents[T]) find(label T) T {
+	root := label
+	for u.parent[root] != root {
+		root = u.parent[root]
+	}
+
+	for label != root {
+		next := u.parent[label]
+		u.parent[label] = root
+		label = next
+	}
+
+	return root
+}
@@ -0,0 +1,135 @@
+package connectivity
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+	"github.com/gavinhailey/gograph/traverse"
+)
+
+func TestUnionFindComponents(t *testing.T) {
+	u := NewUnionFindComponents[int]()
+
+	if u.ComponentCount() != 0 {
+		t.Errorf("Expected 0 components, got %d", u.ComponentCount())
+	}
+
+	if !u.Connected(1, 1) {
+		t.Error("Expected a vertex to be connected to itself")
+	}
+
+	if u.Connected(1, 2) {
+		t.Error("Expected unknown vertices not to be connected")
+	}
+
+	u.Add(5)
+	if !u.Union(1, 2) {
+		t.Error("Expected union of 1 and 2 to merge components")
+	}
+
+	if u.ComponentCount() != 2 {
+		t.Errorf("Expected 2 components, got %d", u.ComponentCount())
+	}
+
+	u.Union(3, 4)
+	if u.Connected(1, 4) {
+		t.Error("Expected 1 and 4 not to be connected")
+	}
+
+	if u.ComponentCount() != 3 {
+		t.Errorf("Expected 3 components, got %d", u.ComponentCount())
+	}
+
+	u.Union(2, 3)
+	if !u.Connected(1, 4) {
+		t.Error("Expected 1 and 4 to be connected")
+	}
+
+	if u.Union(4, 1) {
+		t.Error("Expected union of 4 and 1 not to merge components")
+	}
+
+	if u.ComponentCount() != 2 {
+		t.Errorf("Expected 2 components, got %d", u.ComponentCount())
+	}
+
+	if u.Connected(5, 1) {
+		t.Error("Expected 5 and 1 not to be connected")
+	}
+}
+
+func TestUnionFindComponentsMatchesRecompute(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	u := NewUnionFindComponents[int]()
+	g := gograph.New[int]()
+
+	const n = 50
+	for i := 0; i < n; i++ {
+		u.Add(i)
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 0; i < 60; i++ {
+		a, b := rng.Intn(n), rng.Intn(n)
+		u.Union(a, b)
+		if a != b {
+			_, _ = g.AddEdge(g.GetVertexByID(a), g.GetVertexByID(b))
+		}
+
+		components, _ := ConnectedComponents(g)
+		if got, want := u.ComponentCount(), len(components); got != want {
+			t.Fatalf("After %d edges expected %d components, got %d", i+1, want, got)
+		}
+
+		x, y := rng.Intn(n), rng.Intn(n)
+		iter, _ := traverse.NewBreadthFirstIterator(g, x)
+		var reachable bool
+		_ = iter.Iterate(func(v *gograph.Vertex[int]) error {
+			reachable = reachable || v.Label() == y
+			return nil
+		})
+
+		if u.Connected(x, y) != reachable {
+			t.Fatalf("Expected Connected(%d, %d) to be %v", x, y, reachable)
+		}
+	}
+}
+
+func benchmarkEdges(n int) [][2]int {
+	rng := rand.New(rand.NewSource(1))
+	edges := make([][2]int, n)
+	for i := range edges {
+		edges[i] = [2]int{rng.Intn(n), rng.Intn(n)}
+	}
+
+	return edges
+}
+
+func BenchmarkUnionFindComponents(b *testing.B) {
+	edges := benchmarkEdges(500)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		u := NewUnionFindComponents[int]()
+		for _, e := range edges {
+			u.Union(e[0], e[1])
+			_ = u.ComponentCount()
+		}
+	}
+}
+
+func BenchmarkRecomputeComponents(b *testing.B) {
+	edges := benchmarkEdges(500)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g := gograph.New[int]()
+		for _, e := range edges {
+			if e[0] != e[1] {
+				_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+			}
+			_, _ = ConnectedComponents(g)
+		}
+	}
+}
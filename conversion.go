@@ -0,0 +1,136 @@
+package gograph
+
+import "math"
+
+// AsDirected returns a new directed graph with the same vertices as the
+// input graph, where each undirected edge is replaced by two directed
+// edges in opposite directions with the same weight. If the input graph is
+// already directed, it returns a copy of it.
+//
+// The returned graph is weighted if the input graph is weighted. The input
+// graph is not modified.
+func AsDirected[T comparable](g Graph[T]) Graph[T] {
+	options := []GraphOptionFunc{Directed()}
+	if g.IsWeighted() {
+		options = append(options, Weighted())
+	}
+
+	directed := New[T](options...)
+	copyVertices(g, directed)
+
+	// undirected edges are already stored in both directions
+	for _, edge := range g.AllEdges() {
+		_, _ = directed.AddEdge(
+			directed.GetVertexByID(edge.source.label),
+			directed.GetVertexByID(edge.dest.label),
+			WithEdgeWeight(edge.Weight()),
+		)
+	}
+
+	return directed
+}
+
+// AsUndirected returns a new undirected graph with the same vertices as the
+// input graph, where each directed edge becomes an undirected edge. If both
+// directions of an edge exist, they collapse into one undirected edge, and
+// its weight is computed by the combine function, which is called with the
+// weight of the edge that was added first to the input graph and the weight
+// of its reverse edge. If combine is nil, the weight of the edge that was
+// added first is kept.
+//
+// The returned graph is weighted if the input graph is weighted. The input
+// graph is not modified.
+func AsUndirected[T comparable](g Graph[T], combine func(a, b float64) float64) Graph[T] {
+	var options []GraphOptionFunc
+	if g.IsWeighted() {
+		options = append(options, Weighted())
+	}
+
+	undirected := New[T](options...)
+	copyVertices(g, undirected)
+
+	for _, edge := range g.AllEdges() {
+		from := undirected.GetVertexByID(edge.source.label)
+		to := undirected.GetVertexByID(edge.dest.label)
+		if undirected.ContainsEdge(from, to) {
+			continue
+		}
+
+		weight := edge.Weight()
+		if g.IsDirected() {
+			reverse, first := reverseEdge(g, edge)
+			if !first {
+				continue
+			}
+
+			if reverse != nil && combine != nil {
+				weight = combine(weight, reverse.Weight())
+			}
+		}
+
+		_, _ = undirected.AddEdge(from, to, WithEdgeWeight(weight))
+	}
+
+	return undirected
+}
+
+// Symmetrize converts the directed weighted graph into an undirected one,
+// with the same vertices. Each pair of vertices connected in one or both
+// directions gets a single undirected edge, whose weight is computed by the
+// reduce function, for example max, min or the average. It is called with
+// the weight of the edge that was added first to the graph as forward, and
+// the weight of its reverse edge as backward. If the reverse edge doesn't
+// exist, backward is NaN, so the reducer can handle one-sided pairs with
+// math.IsNaN. The reverse of a self-loop is itself.
+//
+// It returns ErrNotDirected if the graph is not directed, and
+// ErrNotWeighted if it is not weighted. The input graph is not modified.
+func Symmetrize[T comparable](g Graph[T], reduce func(forward, backward float64) float64) (Graph[T], error) {
+	if !g.IsDirected() {
+		return nil, ErrNotDirected
+	}
+
+	if !g.IsWeighted() {
+		return nil, ErrNotWeighted
+	}
+
+	undirected := New[T](Weighted())
+	copyVertices(g, undirected)
+
+	for _, edge := range g.AllEdges() {
+		from := undirected.GetVertexByID(edge.source.label)
+		to := undirected.GetVertexByID(edge.dest.label)
+
+		// the pair is reduced once, from the edge that was added first
+		reverse, first := reverseEdge(g, edge)
+		if !first || undirected.ContainsEdge(from, to) {
+			continue
+		}
+
+		backward := math.NaN()
+		if reverse != nil {
+			backward = reverse.Weight()
+		}
+
+		_, _ = undirected.AddEdge(from, to, WithEdgeWeight(reduce(edge.Weight(), backward)))
+	}
+
+	return undirected, nil
+}
+
+// reverseEdge returns the reverse of the directed edge, if it exists, and
+// whether the edge comes first in its pair, i.e., it was added to the graph
+// before its reverse. It gives the conversions a fixed direction for each
+// pair, regardless of the order of AllEdges.
+func reverseEdge[T comparable](g Graph[T], edge *Edge[T]) (*Edge[T], bool) {
+	reverse := g.GetEdge(edge.dest, edge.source)
+	return reverse, reverse == nil || edge.id <= reverse.id
+}
+
+// copyVertices adds all the vertices of the source graph along with
+// their weights to the destination graph.
+func copyVertices[T comparable](src, dst Graph[T]) {
+	for _, v := range src.GetAllVertices() {
+		dst.AddVertexByLabel(v.label, WithVertexWeight(v.Weight()))
+	}
+}
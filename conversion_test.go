@@ -0,0 +1,209 @@
+package gograph
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestAsDirected(t *testing.T) {
+	g := New[string](Weighted())
+	vA := g.AddVertexByLabel("A", WithVertexWeight(2))
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	_, _ = g.AddEdge(vA, vB, WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vC, WithEdgeWeight(5))
+
+	directed := AsDirected(g)
+	if !directed.IsDirected() || !directed.IsWeighted() {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	if Stats(directed).EdgeCount != 2*Stats(g).EdgeCount {
+		t.Errorf(testErrMsgNotEqual, 2*Stats(g).EdgeCount, Stats(directed).EdgeCount)
+	}
+
+	if !directed.HasEdge("C", "B") || !directed.HasEdge("B", "C") {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	edge := directed.GetEdge(directed.GetVertexByID("C"), directed.GetVertexByID("B"))
+	if edge.Weight() != 5 {
+		t.Errorf(testErrMsgNotEqual, 5, edge.Weight())
+	}
+
+	if directed.GetVertexByID("A").Weight() != 2 {
+		t.Errorf(testErrMsgNotEqual, 2, directed.GetVertexByID("A").Weight())
+	}
+
+	// the input graph must not be modified
+	if g.IsDirected() || Stats(g).EdgeCount != 2 {
+		t.Error("expected the input graph to be unchanged")
+	}
+}
+
+func TestAsUndirected(t *testing.T) {
+	g := New[string](Directed(), Weighted())
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	_, _ = g.AddEdge(vA, vB, WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vA, WithEdgeWeight(3))
+	_, _ = g.AddEdge(vB, vC, WithEdgeWeight(5))
+	_, _ = g.AddEdge(vC, vB, WithEdgeWeight(7))
+
+	undirected := AsUndirected(g, func(a, b float64) float64 {
+		return a + b
+	})
+	if undirected.IsDirected() || !undirected.IsWeighted() {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	if Stats(undirected).EdgeCount != Stats(g).EdgeCount/2 {
+		t.Errorf(testErrMsgNotEqual, Stats(g).EdgeCount/2, Stats(undirected).EdgeCount)
+	}
+
+	expected := map[[2]string]float64{{"A", "B"}: 4, {"B", "C"}: 12}
+	for pair, weight := range expected {
+		edges := undirected.GetAllEdges(undirected.GetVertexByID(pair[0]), undirected.GetVertexByID(pair[1]))
+		if len(edges) != 2 {
+			t.Fatalf(testErrMsgWrongLen, 2, len(edges))
+		}
+
+		for _, edge := range edges {
+			if edge.Weight() != weight {
+				t.Errorf(testErrMsgNotEqual, weight, edge.Weight())
+			}
+		}
+	}
+
+	// the input graph must not be modified
+	if g.GetEdge(vA, vB).Weight() != 1 || g.Size() != 4 {
+		t.Error("expected the input graph to be unchanged")
+	}
+}
+
+func TestSymmetrize(t *testing.T) {
+	g := New[string](Directed(), Weighted())
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	_, _ = g.AddEdge(vA, vB, WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vA, WithEdgeWeight(3))
+	_, _ = g.AddEdge(vB, vC, WithEdgeWeight(5))
+	_, _ = g.AddEdge(vD, vD, WithEdgeWeight(2))
+
+	tests := []struct {
+		name     string
+		reduce   func(forward, backward float64) float64
+		expected map[[2]string]float64
+	}{
+		{
+			name: "max",
+			reduce: func(forward, backward float64) float64 {
+				if math.IsNaN(backward) {
+					return forward
+				}
+				return math.Max(forward, backward)
+			},
+			expected: map[[2]string]float64{{"A", "B"}: 3, {"B", "C"}: 5, {"D", "D"}: 2},
+		},
+		{
+			name: "average with a missing direction as zero",
+			reduce: func(forward, backward float64) float64 {
+				if math.IsNaN(backward) {
+					backward = 0
+				}
+				return (forward + backward) / 2
+			},
+			expected: map[[2]string]float64{{"A", "B"}: 2, {"B", "C"}: 2.5, {"D", "D"}: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			undirected, err := Symmetrize(g, tt.reduce)
+			if err != nil {
+				t.Fatalf(testErrMsgError, err)
+			}
+
+			if undirected.IsDirected() || !undirected.IsWeighted() {
+				t.Error(testErrMsgNotFalse)
+			}
+
+			if undirected.Order() != g.Order() {
+				t.Errorf(testErrMsgNotEqual, g.Order(), undirected.Order())
+			}
+
+			for pair, weight := range tt.expected {
+				for _, from := range []string{pair[0], pair[1]} {
+					to := pair[0]
+					if from == pair[0] {
+						to = pair[1]
+					}
+
+					edge := undirected.GetEdge(undirected.GetVertexByID(from), undirected.GetVertexByID(to))
+					if edge == nil {
+						t.Fatalf("expected edge %s-%s", from, to)
+					}
+
+					if edge.Weight() != weight {
+						t.Errorf(testErrMsgNotEqual, weight, edge.Weight())
+					}
+				}
+			}
+
+			if undirected.ContainsEdge(undirected.GetVertexByID("A"), undirected.GetVertexByID("C")) {
+				t.Error(testErrMsgNotFalse)
+			}
+		})
+	}
+
+	if _, err := Symmetrize(New[string](Weighted()), nil); !errors.Is(err, ErrNotDirected) {
+		t.Errorf(testErrMsgNotEqual, ErrNotDirected, err)
+	}
+
+	if _, err := Symmetrize(New[string](Directed()), nil); !errors.Is(err, ErrNotWeighted) {
+		t.Errorf(testErrMsgNotEqual, ErrNotWeighted, err)
+	}
+}
+
+func TestConversionsDirectionOrder(t *testing.T) {
+	g := New[string](Directed(), Weighted())
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	_, _ = g.AddEdge(vA, vB, WithEdgeWeight(10))
+	_, _ = g.AddEdge(vB, vA, WithEdgeWeight(3))
+	_, _ = g.AddEdge(vC, vB, WithEdgeWeight(4))
+	_, _ = g.AddEdge(vB, vC, WithEdgeWeight(1))
+
+	// the edge added first is always forward, so the difference doesn't
+	// depend on the order of the edges in the maps
+	subtract := func(forward, backward float64) float64 { return forward - backward }
+	expected := map[[2]string]float64{{"A", "B"}: 7, {"B", "C"}: 3}
+
+	for i := 0; i < 20; i++ {
+		symmetrized, err := Symmetrize(g, subtract)
+		if err != nil {
+			t.Fatalf(testErrMsgError, err)
+		}
+
+		for name, undirected := range map[string]Graph[string]{
+			"Symmetrize":   symmetrized,
+			"AsUndirected": AsUndirected(g, subtract),
+		} {
+			for pair, weight := range expected {
+				edge := undirected.GetEdge(undirected.GetVertexByID(pair[1]), undirected.GetVertexByID(pair[0]))
+				if edge == nil {
+					t.Fatalf("%s: expected edge %s-%s", name, pair[0], pair[1])
+				}
+
+				if edge.Weight() != weight {
+					t.Errorf("%s: "+testErrMsgNotEqual, name, weight, edge.Weight())
+				}
+			}
+		}
+	}
+}
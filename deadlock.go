@@ -0,0 +1,105 @@
+package gograph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DeadlockReport describes a deadlock of a wait-for graph, which is a cycle
+// of vertices waiting for each other, and the edge suggested to break it.
+type DeadlockReport[T comparable] struct {
+	// Chain is the waiting chain of the deadlock: each vertex waits for the
+	// next one, and the last vertex waits for the first one. A vertex that
+	// waits for itself forms a chain of one vertex.
+	Chain []T
+
+	// Break is the suggested edge to remove, which goes from the last vertex
+	// of the chain to the first one.
+	Break *Edge[T]
+}
+
+// String explains the deadlock, e.g. "A waits for B, B waits for C, C waits
+// for A; remove C -> A to break it".
+func (r DeadlockReport[T]) String() string {
+	waits := make([]string, len(r.Chain))
+	for i, label := range r.Chain {
+		waits[i] = fmt.Sprintf("%v waits for %v", label, r.Chain[(i+1)%len(r.Chain)])
+	}
+
+	return fmt.Sprintf("%s; remove %v -> %v to break it",
+		strings.Join(waits, ", "), r.Break.source.label, r.Break.dest.label)
+}
+
+// AnalyzeDeadlocks finds the deadlocks of a directed wait-for graph, such as
+// a lock-ordering graph, where an edge from A to B means that A waits for B.
+// It computes a small set of edges whose removal makes the graph acyclic
+// with FeedbackArcSet, and reports the shortest waiting chain that each of
+// them closes. Removing the suggested edges of all the reports breaks all
+// the deadlocks. The reports are sorted by the fmt representation of the
+// labels of their break edges.
+//
+// A graph without cycles has no reports. Since finding the minimum set of
+// edges is NP-hard, the number of reports may be more than the minimum
+// number of edges needed to break all the cycles.
+//
+// It returns ErrNotDirected if the graph is not directed.
+func AnalyzeDeadlocks[T comparable](g Graph[T]) ([]DeadlockReport[T], error) {
+	arcs, err := FeedbackArcSet(g)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]DeadlockReport[T], 0, len(arcs))
+	for _, arc := range arcs {
+		chain := shortestChain(arc.dest, arc.source)
+		if chain == nil {
+			continue
+		}
+
+		reports = append(reports, DeadlockReport[T]{Chain: chain, Break: arc})
+	}
+
+	key := func(r DeadlockReport[T]) string {
+		return fmt.Sprint(r.Break.source.label) + "\x00" + fmt.Sprint(r.Break.dest.label)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return key(reports[i]) < key(reports[j])
+	})
+
+	return reports, nil
+}
+
+// shortestChain returns the labels of the vertices of the shortest path
+// from the 'from' vertex to the 'to' vertex, found by a BFS, or nil if the
+// 'to' vertex is not reachable.
+func shortestChain[T comparable](from, to *Vertex[T]) []T {
+	parent := map[T]*Vertex[T]{from.label: nil}
+	queue := []*Vertex[T]{from}
+	for len(queue) > 0 && queue[0].label != to.label {
+		curr := queue[0]
+		queue = queue[1:]
+
+		for _, neighbor := range curr.adjacent() {
+			if _, ok := parent[neighbor.label]; !ok {
+				parent[neighbor.label] = curr
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	if _, ok := parent[to.label]; !ok {
+		return nil
+	}
+
+	var chain []T
+	for v := to; v != nil; v = parent[v.label] {
+		chain = append(chain, v.label)
+	}
+
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+
+	return chain
+}
@@ -0,0 +1,88 @@
+package gograph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAnalyzeDeadlocks(t *testing.T) {
+	// T1 waits for T2, T2 waits for T3 and T3 waits for T1, which is the
+	// deadlock. T5 waits for T1 and T3 waits for T4, but they are not part
+	// of it.
+	g := New[string](Directed())
+	for _, e := range [][2]string{{"T5", "T1"}, {"T1", "T2"}, {"T2", "T3"}, {"T3", "T1"}, {"T3", "T4"}} {
+		_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+	}
+
+	reports, err := AnalyzeDeadlocks(g)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if len(reports) != 1 {
+		t.Fatalf(testErrMsgWrongLen, 1, len(reports))
+	}
+
+	report := reports[0]
+	if len(report.Chain) != 3 {
+		t.Fatalf(testErrMsgWrongLen, 3, len(report.Chain))
+	}
+
+	// the chain starts at any vertex of the cycle, but it follows the
+	// waiting order
+	start := 0
+	for report.Chain[start] != "T1" {
+		start++
+	}
+	rotated := append(append([]string{}, report.Chain[start:]...), report.Chain[:start]...)
+	if expected := []string{"T1", "T2", "T3"}; !reflect.DeepEqual(rotated, expected) {
+		t.Errorf(testErrMsgNotEqual, expected, report.Chain)
+	}
+
+	if report.Break.Source().Label() != report.Chain[2] || report.Break.Destination().Label() != report.Chain[0] {
+		t.Errorf("expected the break edge %v -> %v to close the chain %v",
+			report.Break.Source().Label(), report.Break.Destination().Label(), report.Chain)
+	}
+
+	g.RemoveEdges(report.Break)
+	if err = AssertDAG(g); err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	reports, _ = AnalyzeDeadlocks(g)
+	if len(reports) != 0 {
+		t.Errorf(testErrMsgWrongLen, 0, len(reports))
+	}
+}
+
+func TestAnalyzeDeadlocksSelfLoop(t *testing.T) {
+	g := New[string](Directed())
+	_, _ = g.AddEdge(NewVertex("L"), NewVertex("L"))
+	_, _ = g.AddEdge(NewVertex("L"), NewVertex("M"))
+
+	reports, err := AnalyzeDeadlocks(g)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if len(reports) != 1 || !reflect.DeepEqual(reports[0].Chain, []string{"L"}) {
+		t.Fatalf(testErrMsgNotEqual, "[L]", reports)
+	}
+
+	if s := reports[0].String(); s != "L waits for L; remove L -> L to break it" {
+		t.Errorf(testErrMsgNotEqual, "L waits for L; remove L -> L to break it", s)
+	}
+
+	report := DeadlockReport[string]{
+		Chain: []string{"A", "B", "C"},
+		Break: NewEdge(NewVertex("C"), NewVertex("A")),
+	}
+	if s := report.String(); s != "A waits for B, B waits for C, C waits for A; remove C -> A to break it" {
+		t.Errorf(testErrMsgNotEqual, "A waits for B, B waits for C, C waits for A; remove C -> A to break it", s)
+	}
+
+	if _, err = AnalyzeDeadlocks(New[string]()); !errors.Is(err, ErrNotDirected) {
+		t.Errorf(testErrMsgNotEqual, ErrNotDirected, err)
+	}
+}
@@ -0,0 +1,116 @@
+package gograph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// EdgeChange represents an edge in a GraphDelta by the labels of its
+// vertices along with its weight.
+type EdgeChange[T comparable] struct {
+	From   T
+	To     T
+	Weight float64
+}
+
+// GraphDelta represents a set of vertices and edges, which are added to or
+// removed from a graph.
+type GraphDelta[T comparable] struct {
+	Vertices []T
+	Edges    []EdgeChange[T]
+}
+
+// Diff compares two versions of a graph by the labels of the vertices and
+// edges, and returns the vertices and edges that are added to the new graph
+// and the ones that are removed from the old graph. If the weight of an edge
+// that exists in both graphs is changed, the edge is reported as removed with
+// its old weight and added with its new weight.
+//
+// In undirected graph, each edge is reported once. The vertices and edges of
+// each delta are sorted by the fmt representation of their labels, so the
+// result is deterministic.
+//
+// It returns ErrGraphTypeMismatch if one of the graphs is directed and the
+// other one is not.
+func Diff[T comparable](old, new Graph[T]) (added, removed GraphDelta[T], err error) {
+	if old.IsDirected() != new.IsDirected() {
+		return added, removed, ErrGraphTypeMismatch
+	}
+
+	added.Vertices = missingVertices(new, old)
+	removed.Vertices = missingVertices(old, new)
+
+	for _, edge := range uniqueEdges(new) {
+		existing := old.GetEdge(old.GetVertexByID(edge.source.label), old.GetVertexByID(edge.dest.label))
+		if existing == nil || existing.Weight() != edge.Weight() {
+			added.Edges = append(added.Edges, newEdgeChange(edge))
+		}
+	}
+
+	for _, edge := range uniqueEdges(old) {
+		existing := new.GetEdge(new.GetVertexByID(edge.source.label), new.GetVertexByID(edge.dest.label))
+		if existing == nil || existing.Weight() != edge.Weight() {
+			removed.Edges = append(removed.Edges, newEdgeChange(edge))
+		}
+	}
+
+	sortEdgeChanges(added.Edges)
+	sortEdgeChanges(removed.Edges)
+
+	return added, removed, nil
+}
+
+// missingVertices returns the labels of the vertices of g that don't exist
+// in the other graph.
+func missingVertices[T comparable](g, other Graph[T]) []T {
+	var labels []T
+	for _, v := range g.GetAllVertices() {
+		if other.GetVertexByID(v.label) == nil {
+			labels = append(labels, v.label)
+		}
+	}
+
+	sort.Slice(labels, func(i, j int) bool {
+		return fmt.Sprint(labels[i]) < fmt.Sprint(labels[j])
+	})
+
+	return labels
+}
+
+// uniqueEdges returns all the edges of the graph. In undirected graph, it
+// only returns the direction of each edge that starts from the vertex with
+// the smaller fmt representation of the label.
+func uniqueEdges[T comparable](g Graph[T]) []*Edge[T] {
+	edges := g.AllEdges()
+	if g.IsDirected() {
+		return edges
+	}
+
+	seen := make(map[[2]T]bool, len(edges))
+	unique := make([]*Edge[T], 0, len(edges)/2)
+	for _, edge := range edges {
+		source, dest := fmt.Sprint(edge.source.label), fmt.Sprint(edge.dest.label)
+		if source > dest || (source == dest && seen[[2]T{edge.dest.label, edge.source.label}]) {
+			continue
+		}
+
+		seen[[2]T{edge.source.label, edge.dest.label}] = true
+		unique = append(unique, edge)
+	}
+
+	return unique
+}
+
+func newEdgeChange[T comparable](edge *Edge[T]) EdgeChange[T] {
+	return EdgeChange[T]{From: edge.source.label, To: edge.dest.label, Weight: edge.Weight()}
+}
+
+func sortEdgeChanges[T comparable](changes []EdgeChange[T]) {
+	sort.Slice(changes, func(i, j int) bool {
+		fromI, fromJ := fmt.Sprint(changes[i].From), fmt.Sprint(changes[j].From)
+		if fromI != fromJ {
+			return fromI < fromJ
+		}
+		return fmt.Sprint(changes[i].To) < fmt.Sprint(changes[j].To)
+	})
+}
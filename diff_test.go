@@ -0,0 +1,87 @@
+package gograph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	build := func(edges []EdgeChange[string]) Graph[string] {
+		g := New[string](Weighted())
+		for _, e := range edges {
+			_, _ = g.AddEdge(NewVertex(e.From), NewVertex(e.To), WithEdgeWeight(e.Weight))
+		}
+		return g
+	}
+
+	old := build([]EdgeChange[string]{
+		{From: "A", To: "B", Weight: 1},
+		{From: "B", To: "C", Weight: 2},
+		{From: "C", To: "D", Weight: 3},
+	})
+
+	// D - C is removed, B - C has a new weight, and E is added
+	updated := build([]EdgeChange[string]{
+		{From: "B", To: "A", Weight: 1},
+		{From: "C", To: "B", Weight: 5},
+	})
+	updated.AddVertexByLabel("D")
+	updated.AddVertexByLabel("E")
+
+	added, removed, err := Diff(old, updated)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	expectedAdded := GraphDelta[string]{
+		Vertices: []string{"E"},
+		Edges:    []EdgeChange[string]{{From: "B", To: "C", Weight: 5}},
+	}
+	if !reflect.DeepEqual(added, expectedAdded) {
+		t.Errorf(testErrMsgNotEqual, expectedAdded, added)
+	}
+
+	expectedRemoved := GraphDelta[string]{
+		Edges: []EdgeChange[string]{
+			{From: "B", To: "C", Weight: 2},
+			{From: "C", To: "D", Weight: 3},
+		},
+	}
+	if !reflect.DeepEqual(removed, expectedRemoved) {
+		t.Errorf(testErrMsgNotEqual, expectedRemoved, removed)
+	}
+
+	// the diff of equal graphs is empty
+	added, removed, _ = Diff(old, Clone(old))
+	if len(added.Vertices)+len(added.Edges)+len(removed.Vertices)+len(removed.Edges) != 0 {
+		t.Errorf("expected an empty diff, got %v and %v", added, removed)
+	}
+}
+
+func TestDiffDirected(t *testing.T) {
+	old := New[int](Directed())
+	_, _ = old.AddEdge(NewVertex(1), NewVertex(2))
+
+	updated := New[int](Directed())
+	_, _ = updated.AddEdge(NewVertex(2), NewVertex(1))
+	updated.AddVertexByLabel(3)
+
+	added, removed, err := Diff(old, updated)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if !reflect.DeepEqual(added.Edges, []EdgeChange[int]{{From: 2, To: 1}}) ||
+		!reflect.DeepEqual(added.Vertices, []int{3}) {
+		t.Errorf(testErrMsgNotEqual, "2 -> 1 and 3", added)
+	}
+
+	if !reflect.DeepEqual(removed.Edges, []EdgeChange[int]{{From: 1, To: 2}}) {
+		t.Errorf(testErrMsgNotEqual, "1 -> 2", removed)
+	}
+
+	if _, _, err = Diff(old, New[int]()); !errors.Is(err, ErrGraphTypeMismatch) {
+		t.Errorf(testErrMsgNotEqual, ErrGraphTypeMismatch, err)
+	}
+}
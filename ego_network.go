@@ -0,0 +1,37 @@
+package gograph
+
+// EgoNetwork returns a new graph induced by the center vertex and all the
+// vertices within the given number of hops from it, along with all the
+// edges among them, which is the common neighborhood view of a social
+// network. A radius of 1 gives the immediate neighborhood, and a radius of
+// 0 or less gives the center alone. In a directed graph, the hops follow
+// the outgoing edges.
+//
+// The returned graph has the same properties as the input graph, the vertex
+// and edge weights are preserved, and the input graph is not modified.
+//
+// It returns ErrVertexDoesNotExist if the center vertex doesn't exist.
+func EgoNetwork[T comparable](g Graph[T], center T, radius int) (Graph[T], error) {
+	v := g.GetVertexByID(center)
+	if v == nil {
+		return nil, ErrVertexDoesNotExist
+	}
+
+	ego := map[T]bool{v.label: true}
+	frontier := []*Vertex[T]{v}
+	for hop := 0; hop < radius && len(frontier) > 0; hop++ {
+		var next []*Vertex[T]
+		for _, curr := range frontier {
+			for _, neighbor := range curr.adjacent() {
+				if !ego[neighbor.label] {
+					ego[neighbor.label] = true
+					next = append(next, neighbor)
+				}
+			}
+		}
+
+		frontier = next
+	}
+
+	return induced(g, func(label T) bool { return ego[label] }), nil
+}
@@ -0,0 +1,74 @@
+package gograph
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestEgoNetwork(t *testing.T) {
+	// the undirected graph
+	//	a - b - c - d
+	//	|       |
+	//	e       f - g
+	g := New[string]()
+	for _, e := range [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"a", "e"}, {"c", "f"}, {"f", "g"}} {
+		_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+	}
+
+	tests := []struct {
+		radius   int
+		expected []string
+		size     int
+	}{
+		{radius: 0, expected: []string{"b"}, size: 0},
+		{radius: 1, expected: []string{"a", "b", "c"}, size: 4},
+		{radius: 2, expected: []string{"a", "b", "c", "d", "e", "f"}, size: 10},
+	}
+
+	for _, tt := range tests {
+		ego, err := EgoNetwork(g, "b", tt.radius)
+		if err != nil {
+			t.Fatalf(testErrMsgError, err)
+		}
+
+		labels := extractLabels(ego.GetAllVertices())
+		sort.Strings(labels)
+		if !reflect.DeepEqual(labels, tt.expected) {
+			t.Errorf(testErrMsgNotEqual, tt.expected, labels)
+		}
+
+		if ego.Size() != uint32(tt.size) {
+			t.Errorf(testErrMsgWrongLen, tt.size, ego.Size())
+		}
+	}
+
+	if _, err := EgoNetwork(g, "x", 1); !errors.Is(err, ErrVertexDoesNotExist) {
+		t.Errorf(testErrMsgNotEqual, ErrVertexDoesNotExist, err)
+	}
+}
+
+func TestEgoNetwork_Directed(t *testing.T) {
+	// a -> b -> c, and d -> b, where d is not reachable from b
+	g := New[string](Directed())
+	for _, e := range [][2]string{{"a", "b"}, {"b", "c"}, {"d", "b"}, {"c", "a"}} {
+		_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+	}
+
+	ego, err := EgoNetwork(g, "b", 1)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	labels := extractLabels(ego.GetAllVertices())
+	sort.Strings(labels)
+	if expected := []string{"b", "c"}; !reflect.DeepEqual(labels, expected) {
+		t.Errorf(testErrMsgNotEqual, expected, labels)
+	}
+
+	ego, _ = EgoNetwork(g, "b", 2)
+	if ego.Order() != 3 || ego.Size() != 3 {
+		t.Errorf(testErrMsgNotEqual, "3 vertices and 3 edges", [2]uint32{ego.Order(), ego.Size()})
+	}
+}
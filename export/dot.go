@@ -0,0 +1,51 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// ExportDOT writes the specified graph to the writer in the Graphviz DOT
+// language. Directed graphs are written as 'digraph' and undirected graphs
+// as 'graph'. In weighted graphs, the edge weights are written as the
+// 'weight' attribute of the edges.
+//
+// Vertices and edges are written in the ascending order of their rendered
+// labels, so the output is deterministic.
+func ExportDOT[T comparable](g gograph.Graph[T], w io.Writer, options ...OptionFunc[T]) error {
+	properties := newProperties(options...)
+	bw := bufio.NewWriter(w)
+
+	graphType, edgeOp := "graph", "--"
+	if g.IsDirected() {
+		graphType, edgeOp = "digraph", "->"
+	}
+
+	_, _ = fmt.Fprintf(bw, "%s {\n", graphType)
+
+	for _, label := range sortedVertices(g, properties) {
+		_, _ = fmt.Fprintf(bw, "\t%s;\n", dotID(label))
+	}
+
+	for _, e := range sortedEdges(g, properties) {
+		_, _ = fmt.Fprintf(bw, "\t%s %s %s", dotID(e.source), edgeOp, dotID(e.dest))
+		if g.IsWeighted() {
+			_, _ = fmt.Fprintf(bw, " [weight=%s]", strconv.FormatFloat(e.edge.Weight(), 'g', -1, 64))
+		}
+		_, _ = bw.WriteString(";\n")
+	}
+
+	_, _ = bw.WriteString("}\n")
+
+	return bw.Flush()
+}
+
+// dotID quotes the input string as a DOT identifier.
+func dotID(s string) string {
+	return `"` + strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), `"`, `\"`) + `"`
+}
@@ -0,0 +1,87 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestExportDOT(t *testing.T) {
+	g := gograph.New[int](gograph.Directed(), gograph.Weighted())
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+	_, _ = g.AddEdge(v2, v3, gograph.WithEdgeWeight(2.5))
+	_, _ = g.AddEdge(v1, v2, gograph.WithEdgeWeight(1))
+
+	var buf bytes.Buffer
+	if err := ExportDOT(g, &buf); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := `digraph {
+	"1";
+	"2";
+	"3";
+	"1" -> "2" [weight=1];
+	"2" -> "3" [weight=2.5];
+}
+`
+	if buf.String() != expected {
+		t.Errorf("Expected output:\n%s\nbut got:\n%s", expected, buf.String())
+	}
+}
+
+func TestExportDOTUndirected(t *testing.T) {
+	g := gograph.New[string]()
+	_, _ = g.AddEdge(gograph.NewVertex("B"), gograph.NewVertex("A"))
+	_, _ = g.AddEdge(gograph.NewVertex(`say "hi"`), gograph.NewVertex("A"))
+
+	var buf bytes.Buffer
+	if err := ExportDOT(g, &buf); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := `graph {
+	"A";
+	"B";
+	"say \"hi\"";
+	"A" -- "B";
+	"A" -- "say \"hi\"";
+}
+`
+	if buf.String() != expected {
+		t.Errorf("Expected output:\n%s\nbut got:\n%s", expected, buf.String())
+	}
+}
+
+func TestExportDOTWithLabelFormatter(t *testing.T) {
+	type person struct {
+		id   int
+		name string
+	}
+
+	g := gograph.New[person](gograph.Directed())
+	alice := g.AddVertexByLabel(person{id: 1, name: "alice"})
+	bob := g.AddVertexByLabel(person{id: 2, name: "bob"})
+	_, _ = g.AddEdge(alice, bob)
+
+	var buf bytes.Buffer
+	err := ExportDOT(g, &buf, WithLabelFormatter(func(p person) string {
+		return p.name
+	}))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := `digraph {
+	"alice";
+	"bob";
+	"alice" -> "bob";
+}
+`
+	if buf.String() != expected {
+		t.Errorf("Expected output:\n%s\nbut got:\n%s", expected, buf.String())
+	}
+}
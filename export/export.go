@@ -0,0 +1,55 @@
+package export
+
+import (
+	"sort"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// exportEdge is an edge with its rendered source and destination labels.
+type exportEdge[T comparable] struct {
+	source string
+	dest   string
+	edge   *gograph.Edge[T]
+}
+
+// sortedVertices returns the rendered labels of all vertices of the graph
+// in ascending order, to make the output deterministic.
+func sortedVertices[T comparable](g gograph.Graph[T], properties Properties[T]) []string {
+	vertices := g.GetAllVertices()
+	labels := make([]string, 0, len(vertices))
+	for _, v := range vertices {
+		labels = append(labels, properties.labelFormatter(v.Label()))
+	}
+
+	sort.Strings(labels)
+	return labels
+}
+
+// sortedEdges returns all edges of the graph sorted by their rendered
+// source and destination labels. In undirected graph, each edge is
+// returned only once.
+func sortedEdges[T comparable](g gograph.Graph[T], properties Properties[T]) []exportEdge[T] {
+	edges := g.AllEdges()
+	out := make([]exportEdge[T], 0, len(edges))
+	for _, edge := range edges {
+		source := properties.labelFormatter(edge.Source().Label())
+		dest := properties.labelFormatter(edge.Destination().Label())
+
+		// undirected edges are stored in both directions, keep one of them
+		if !g.IsDirected() && source > dest {
+			continue
+		}
+
+		out = append(out, exportEdge[T]{source: source, dest: dest, edge: edge})
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].source != out[j].source {
+			return out[i].source < out[j].source
+		}
+		return out[i].dest < out[j].dest
+	})
+
+	return out
+}
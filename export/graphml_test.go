@@ -0,0 +1,54 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestExportGraphML(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"), gograph.WithEdgeWeight(3))
+	g.AddVertexByLabel("<C>")
+
+	var buf bytes.Buffer
+	if err := ExportGraphML(g, &buf); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="weight" for="edge" attr.name="weight" attr.type="double"/>
+  <graph edgedefault="undirected">
+    <node id="&lt;C&gt;"/>
+    <node id="A"/>
+    <node id="B"/>
+    <edge source="A" target="B">
+      <data key="weight">3</data>
+    </edge>
+  </graph>
+</graphml>
+`
+	if buf.String() != expected {
+		t.Errorf("Expected output:\n%s\nbut got:\n%s", expected, buf.String())
+	}
+}
+
+func TestExportGraphMLWithLabelFormatter(t *testing.T) {
+	g := gograph.New[int](gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+
+	var buf bytes.Buffer
+	err := ExportGraphML(g, &buf, WithLabelFormatter(func(label int) string {
+		return "node-" + strings.Repeat("i", label)
+	}))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !strings.Contains(buf.String(), `<edge source="node-i" target="node-ii"/>`) {
+		t.Errorf("Expected formatted labels in the output, but got:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,70 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// ExportMermaid writes the specified graph to the writer in the Mermaid
+// flowchart syntax, which is rendered as a diagram by GitHub and many other
+// Markdown renderers. Directed graphs are written as 'graph TD' with arrow
+// edges, and undirected graphs as 'graph LR' with plain lines. In weighted
+// graphs, the edge weights are written as the edge labels.
+//
+// Mermaid node ids only allow a restricted set of characters, so vertices
+// are written with generated ids (n0, n1, ...) and their rendered labels as
+// quoted node text, in which the characters with a special meaning are
+// replaced by Mermaid entity codes.
+//
+// Vertices and edges are written in the ascending order of their rendered
+// labels, so the output is deterministic.
+func ExportMermaid[T comparable](g gograph.Graph[T], w io.Writer, options ...OptionFunc[T]) error {
+	properties := newProperties(options...)
+	bw := bufio.NewWriter(w)
+
+	direction, edgeOp := "LR", "---"
+	if g.IsDirected() {
+		direction, edgeOp = "TD", "-->"
+	}
+
+	_, _ = fmt.Fprintf(bw, "graph %s\n", direction)
+
+	ids := make(map[string]string)
+	for i, label := range sortedVertices(g, properties) {
+		ids[label] = "n" + strconv.Itoa(i)
+		_, _ = fmt.Fprintf(bw, "    %s[\"%s\"]\n", ids[label], mermaidEscape(label))
+	}
+
+	for _, e := range sortedEdges(g, properties) {
+		_, _ = fmt.Fprintf(bw, "    %s %s", ids[e.source], edgeOp)
+		if g.IsWeighted() {
+			_, _ = fmt.Fprintf(bw, "|%s|", strconv.FormatFloat(e.edge.Weight(), 'g', -1, 64))
+		}
+		_, _ = fmt.Fprintf(bw, " %s\n", ids[e.dest])
+	}
+
+	return bw.Flush()
+}
+
+// mermaidEscapeReplacer replaces the characters that would break a quoted
+// Mermaid node text by their entity codes. '#' is replaced too, since it
+// starts an entity code.
+var mermaidEscapeReplacer = strings.NewReplacer(
+	"#", "#35;",
+	`"`, "#quot;",
+	"<", "#lt;",
+	">", "#gt;",
+	"\r", " ",
+	"\n", " ",
+)
+
+// mermaidEscape escapes the input string to be used as a quoted Mermaid
+// node text.
+func mermaidEscape(s string) string {
+	return mermaidEscapeReplacer.Replace(s)
+}
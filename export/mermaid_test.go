@@ -0,0 +1,55 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestExportMermaid(t *testing.T) {
+	g := gograph.New[int](gograph.Directed(), gograph.Weighted())
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+	_, _ = g.AddEdge(v2, v3, gograph.WithEdgeWeight(2.5))
+	_, _ = g.AddEdge(v1, v2, gograph.WithEdgeWeight(1))
+
+	var buf bytes.Buffer
+	if err := ExportMermaid(g, &buf); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := `graph TD
+    n0["1"]
+    n1["2"]
+    n2["3"]
+    n0 -->|1| n1
+    n1 -->|2.5| n2
+`
+	if buf.String() != expected {
+		t.Errorf("Expected output:\n%s\nbut got:\n%s", expected, buf.String())
+	}
+}
+
+func TestExportMermaidUndirected(t *testing.T) {
+	g := gograph.New[string]()
+	_, _ = g.AddEdge(gograph.NewVertex("B"), gograph.NewVertex("A"))
+	_, _ = g.AddEdge(gograph.NewVertex(`say "hi" <#1>`), gograph.NewVertex("A"))
+
+	var buf bytes.Buffer
+	if err := ExportMermaid(g, &buf); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := `graph LR
+    n0["A"]
+    n1["B"]
+    n2["say #quot;hi#quot; #lt;#35;1#gt;"]
+    n0 --- n1
+    n0 --- n2
+`
+	if buf.String() != expected {
+		t.Errorf("Expected output:\n%s\nbut got:\n%s", expected, buf.String())
+	}
+}
@@ -0,0 +1,40 @@
+package export
+
+import "fmt"
+
+// OptionFunc represent an alias of function type that modifies the
+// specified export properties.
+type OptionFunc[T comparable] func(properties *Properties[T])
+
+// Properties represents the properties that control how a graph is
+// rendered by the export functions.
+type Properties[T comparable] struct {
+	labelFormatter func(T) string
+}
+
+func newProperties[T comparable](options ...OptionFunc[T]) Properties[T] {
+	properties := Properties[T]{
+		labelFormatter: func(label T) string {
+			return fmt.Sprint(label)
+		},
+	}
+
+	for _, option := range options {
+		option(&properties)
+	}
+
+	return properties
+}
+
+// WithLabelFormatter returns an OptionFunc that sets the function used to
+// render the vertex labels. By default, labels are rendered by fmt.Sprint.
+//
+// Distinct labels should be formatted to distinct strings, otherwise the
+// exported vertices will be merged by the consumer of the output.
+func WithLabelFormatter[T comparable](formatter func(T) string) OptionFunc[T] {
+	return func(properties *Properties[T]) {
+		if formatter != nil {
+			properties.labelFormatter = formatter
+		}
+	}
+}
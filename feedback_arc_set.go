@@ -0,0 +1,109 @@
+package gograph
+
+// FeedbackArcSet returns a small set of edges of a directed graph, whose
+// removal makes the graph acyclic. Finding the minimum feedback arc set is
+// NP-hard, so it uses the greedy heuristic of Eades, Lin and Smyth: it
+// builds a vertex sequence by repeatedly moving the sinks to the end of the
+// sequence, the sources to the start of it, and otherwise the vertex with the
+// largest difference of out-degree and in-degree to the start of it. The
+// edges going backward in the sequence, including self-loops, form the set.
+//
+// The time complexity of this implementation is O(V^2 + E). The result of an
+// acyclic graph is empty.
+//
+// It returns ErrNotDirected if the graph is not directed.
+func FeedbackArcSet[T comparable](g Graph[T]) ([]*Edge[T], error) {
+	if !g.IsDirected() {
+		return nil, ErrNotDirected
+	}
+
+	vertices := g.GetAllVertices()
+	edges := g.AllEdges()
+
+	// the degrees of the remaining vertices, ignoring self-loops
+	inDegrees := make(map[T]int, len(vertices))
+	outDegrees := make(map[T]int, len(vertices))
+	predecessors := make(map[T][]T)
+	for _, edge := range edges {
+		if edge.source.label == edge.dest.label {
+			continue
+		}
+
+		outDegrees[edge.source.label]++
+		inDegrees[edge.dest.label]++
+		predecessors[edge.dest.label] = append(predecessors[edge.dest.label], edge.source.label)
+	}
+
+	remaining := make(map[T]*Vertex[T], len(vertices))
+	for _, v := range vertices {
+		remaining[v.label] = v
+	}
+
+	remove := func(v *Vertex[T]) {
+		delete(remaining, v.label)
+		for _, neighbor := range v.adjacent() {
+			if _, ok := remaining[neighbor.label]; ok {
+				inDegrees[neighbor.label]--
+			}
+		}
+		for _, pred := range predecessors[v.label] {
+			if _, ok := remaining[pred]; ok {
+				outDegrees[pred]--
+			}
+		}
+	}
+
+	var head, tail []*Vertex[T]
+	for len(remaining) > 0 {
+		progress := true
+		for progress {
+			progress = false
+			for _, v := range remaining {
+				switch {
+				case outDegrees[v.label] == 0:
+					tail = append(tail, v)
+				case inDegrees[v.label] == 0:
+					head = append(head, v)
+				default:
+					continue
+				}
+
+				remove(v)
+				progress = true
+			}
+		}
+
+		if len(remaining) == 0 {
+			break
+		}
+
+		var best *Vertex[T]
+		for _, v := range remaining {
+			if best == nil ||
+				outDegrees[v.label]-inDegrees[v.label] > outDegrees[best.label]-inDegrees[best.label] {
+				best = v
+			}
+		}
+
+		head = append(head, best)
+		remove(best)
+	}
+
+	// the sinks are collected in reverse order
+	position := make(map[T]int, len(vertices))
+	for i, v := range head {
+		position[v.label] = i
+	}
+	for i, v := range tail {
+		position[v.label] = len(vertices) - 1 - i
+	}
+
+	arcs := make([]*Edge[T], 0)
+	for _, edge := range edges {
+		if position[edge.source.label] >= position[edge.dest.label] {
+			arcs = append(arcs, edge)
+		}
+	}
+
+	return arcs, nil
+}
@@ -0,0 +1,66 @@
+package gograph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFeedbackArcSet(t *testing.T) {
+	// 0 -> 1 -> 2 -> 3 -> 4, with the single cycle 1 -> 2 -> 3 -> 1
+	g := New[int](Directed())
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 1}, {3, 4}} {
+		_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+	}
+
+	arcs, err := FeedbackArcSet(g)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if len(arcs) != 1 {
+		t.Fatalf(testErrMsgWrongLen, 1, len(arcs))
+	}
+
+	g.RemoveEdges(arcs...)
+	if _, err = TopologySort(g); err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+}
+
+func TestFeedbackArcSetMultipleCycles(t *testing.T) {
+	g := New[int](Directed())
+	edges := [][2]int{{1, 2}, {2, 1}, {2, 3}, {3, 4}, {4, 2}, {4, 5}, {5, 5}, {5, 6}, {6, 4}}
+	for _, e := range edges {
+		_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+	}
+
+	arcs, err := FeedbackArcSet(g)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	g.RemoveEdges(arcs...)
+	if _, err = TopologySort(g); err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+}
+
+func TestFeedbackArcSetAcyclic(t *testing.T) {
+	g := New[int](Acyclic())
+	_, _ = g.AddEdge(NewVertex(1), NewVertex(2))
+	_, _ = g.AddEdge(NewVertex(2), NewVertex(3))
+	_, _ = g.AddEdge(NewVertex(1), NewVertex(3))
+
+	arcs, err := FeedbackArcSet(g)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if len(arcs) != 0 {
+		t.Errorf(testErrMsgWrongLen, 0, len(arcs))
+	}
+
+	if _, err = FeedbackArcSet(New[int]()); !errors.Is(err, ErrNotDirected) {
+		t.Errorf(testErrMsgNotEqual, ErrNotDirected, err)
+	}
+}
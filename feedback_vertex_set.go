@@ -0,0 +1,91 @@
+package gograph
+
+// FeedbackVertexSet returns a small set of vertices of a directed graph,
+// whose removal makes the graph acyclic. It is useful to find the vertices
+// to break the deadlocks of a dependency graph. Finding the minimum feedback
+// vertex set is NP-hard, so it uses a greedy heuristic: it repeatedly drops
+// the remaining vertices without incoming or outgoing edges, which can't be
+// on a cycle, and then moves a vertex with a self-loop, or otherwise the
+// vertex with the largest product of in-degree and out-degree, to the set.
+//
+// The time complexity of this implementation is O(V^2 + E). The result of
+// an acyclic graph is empty.
+//
+// It returns ErrNotDirected if the graph is not directed.
+func FeedbackVertexSet[T comparable](g Graph[T]) ([]*Vertex[T], error) {
+	if !g.IsDirected() {
+		return nil, ErrNotDirected
+	}
+
+	vertices := g.GetAllVertices()
+
+	// the adjacency of the remaining vertices, without self-loops
+	successors := make(map[T]map[T]bool, len(vertices))
+	predecessors := make(map[T]map[T]bool, len(vertices))
+	selfLoops := make(map[T]bool)
+	remaining := make(map[T]*Vertex[T], len(vertices))
+	for _, v := range vertices {
+		successors[v.label] = make(map[T]bool)
+		predecessors[v.label] = make(map[T]bool)
+		remaining[v.label] = v
+	}
+
+	for _, edge := range g.AllEdges() {
+		if edge.source.label == edge.dest.label {
+			selfLoops[edge.source.label] = true
+			continue
+		}
+
+		successors[edge.source.label][edge.dest.label] = true
+		predecessors[edge.dest.label][edge.source.label] = true
+	}
+
+	remove := func(label T) {
+		delete(remaining, label)
+		for succ := range successors[label] {
+			delete(predecessors[succ], label)
+		}
+		for pred := range predecessors[label] {
+			delete(successors[pred], label)
+		}
+	}
+
+	set := make([]*Vertex[T], 0)
+	for len(remaining) > 0 {
+		progress := true
+		for progress {
+			progress = false
+			for label := range remaining {
+				if selfLoops[label] || (len(successors[label]) > 0 && len(predecessors[label]) > 0) {
+					continue
+				}
+
+				remove(label)
+				progress = true
+			}
+		}
+
+		if len(remaining) == 0 {
+			break
+		}
+
+		var best *Vertex[T]
+		bestScore := -1
+		for label, v := range remaining {
+			score := len(successors[label]) * len(predecessors[label])
+			if selfLoops[label] {
+				best = v
+				break
+			}
+
+			if score > bestScore {
+				best, bestScore = v, score
+			}
+		}
+
+		set = append(set, best)
+		remove(best.label)
+	}
+
+	return set, nil
+}
@@ -0,0 +1,73 @@
+package gograph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFeedbackVertexSet(t *testing.T) {
+	tests := []struct {
+		name     string
+		edges    [][2]string
+		expected int
+	}{
+		{
+			name:     "acyclic",
+			edges:    [][2]string{{"A", "B"}, {"B", "C"}, {"A", "C"}},
+			expected: 0,
+		},
+		{
+			name:     "one cycle with tails",
+			edges:    [][2]string{{"X", "A"}, {"A", "B"}, {"B", "C"}, {"C", "D"}, {"D", "A"}, {"C", "Y"}},
+			expected: 1,
+		},
+		{
+			name:     "cycles sharing a vertex",
+			edges:    [][2]string{{"A", "B"}, {"B", "A"}, {"A", "C"}, {"C", "A"}, {"A", "D"}, {"D", "A"}},
+			expected: 1,
+		},
+		{
+			name:     "self-loop",
+			edges:    [][2]string{{"A", "A"}, {"A", "B"}},
+			expected: 1,
+		},
+		{
+			name:     "disjoint cycles",
+			edges:    [][2]string{{"A", "B"}, {"B", "A"}, {"C", "D"}, {"D", "E"}, {"E", "C"}},
+			expected: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := New[string](Directed())
+			for _, e := range tt.edges {
+				_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+			}
+
+			set, err := FeedbackVertexSet(g)
+			if err != nil {
+				t.Fatalf(testErrMsgError, err)
+			}
+
+			if len(set) != tt.expected {
+				t.Fatalf(testErrMsgWrongLen, tt.expected, len(set))
+			}
+
+			labels := make([]string, len(set))
+			for i, v := range set {
+				labels[i] = v.Label()
+			}
+
+			acyclic := Clone(g)
+			acyclic.RemoveVerticesByLabel(labels...)
+			if _, err = TopologySort(acyclic); err != nil {
+				t.Errorf(testErrMsgError, err)
+			}
+		})
+	}
+
+	if _, err := FeedbackVertexSet(New[string]()); !errors.Is(err, ErrNotDirected) {
+		t.Errorf(testErrMsgNotEqual, ErrNotDirected, err)
+	}
+}
@@ -0,0 +1,430 @@
+package gograph
+
+import "sync"
+
+// filteredView is a read-only implementation of the Graph interface, that
+// wraps another graph and hides some of its vertices and edges. The
+// predicates are applied lazily on each call, so the view doesn't copy the
+// underlying graph and it reflects the later changes of it.
+type filteredView[T comparable] struct {
+	graph       Graph[T]
+	edgeAllow   func(*Edge[T]) bool
+	vertexAllow func(*Vertex[T]) bool
+
+	// the handles of the vertices and edges returned by the view, so the
+	// view always returns the same pointer for the same vertex or edge. They
+	// don't hold any adjacency, which is computed on each call.
+	mu       sync.Mutex
+	vertices map[T]*Vertex[T]
+	edges    map[*Edge[T]]*Edge[T]
+}
+
+// FilteredView returns a read-only view of the graph, that only contains the
+// vertices for which vertexAllow returns true, and the edges for which
+// edgeAllow returns true and both of their vertices are allowed. A nil
+// predicate allows everything. In undirected graph, the edge predicate is
+// called for each direction of an edge, so it should be symmetric.
+//
+// The view doesn't copy the underlying graph, and the predicates are applied
+// on each call. The vertices and edges returned by the view are lightweight
+// handles of the ones of the underlying graph: the neighbors and degrees of
+// a vertex are computed by the view when they are read, and the endpoints
+// of an edge are the vertices of the view, so the iterators and algorithms
+// that walk the neighbors or edges traverse the view. Their weights are read
+// from the underlying graph. Computing the in-degree of a vertex of a
+// directed graph scans the graph, so it takes O(V+E).
+//
+// The mutations of the view are rejected: the methods that return error
+// return ErrReadOnlyGraph, and the other ones do nothing.
+func FilteredView[T comparable](
+	g Graph[T],
+	edgeAllow func(*Edge[T]) bool,
+	vertexAllow func(*Vertex[T]) bool,
+) Graph[T] {
+	return &filteredView[T]{
+		graph:       g,
+		edgeAllow:   edgeAllow,
+		vertexAllow: vertexAllow,
+	}
+}
+
+// IsDirected returns true if the underlying graph is directed.
+func (f *filteredView[T]) IsDirected() bool {
+	return f.graph.IsDirected()
+}
+
+// IsAcyclic returns true if the underlying graph is acyclic.
+func (f *filteredView[T]) IsAcyclic() bool {
+	return f.graph.IsAcyclic()
+}
+
+// IsWeighted returns true if the underlying graph is weighted.
+func (f *filteredView[T]) IsWeighted() bool {
+	return f.graph.IsWeighted()
+}
+
+// IsMulti returns true if the underlying graph is a multigraph.
+func (f *filteredView[T]) IsMulti() bool {
+	return f.graph.IsMulti()
+}
+
+// AllowsSelfLoops returns true if the underlying graph allows self loops.
+func (f *filteredView[T]) AllowsSelfLoops() bool {
+	return f.graph.AllowsSelfLoops()
+}
+
+// AddEdge returns ErrReadOnlyGraph, since the view is read-only.
+func (f *filteredView[T]) AddEdge(_, _ *Vertex[T], _ ...EdgeOptionFunc) (*Edge[T], error) {
+	return nil, ErrReadOnlyGraph
+}
+
+// GetOrAddEdge returns the existing allowed edge from the 'from' vertex to
+// the 'to' vertex, or ErrReadOnlyGraph if it doesn't exist, since the view is
+// read-only.
+func (f *filteredView[T]) GetOrAddEdge(from, to *Vertex[T], _ ...EdgeOptionFunc) (*Edge[T], bool, error) {
+	if edge := f.GetEdge(from, to); edge != nil {
+		return edge, false, nil
+	}
+
+	return nil, false, ErrReadOnlyGraph
+}
+
+// GetAllEdges returns the allowed edges connecting the source vertex to the
+// target vertex. If any of the vertices is hidden, returns nil.
+func (f *filteredView[T]) GetAllEdges(from, to *Vertex[T]) []*Edge[T] {
+	source, dest := f.vertex(from), f.vertex(to)
+	if source == nil || dest == nil {
+		return nil
+	}
+
+	return f.filterEdges(f.graph.GetAllEdges(source, dest))
+}
+
+// AllEdges returns all the allowed edges of the view.
+func (f *filteredView[T]) AllEdges() []*Edge[T] {
+	return f.filterEdges(f.graph.AllEdges())
+}
+
+// ForEachEdge calls the callback function on each allowed edge of the view.
+func (f *filteredView[T]) ForEachEdge(fn func(e *Edge[T]) error) error {
+	return f.graph.ForEachEdge(func(e *Edge[T]) error {
+		if !f.edgeAllowed(e) {
+			return nil
+		}
+
+		return fn(f.edgeHandle(e))
+	})
+}
+
+// GetEdge returns the edge connecting the source vertex to the target
+// vertex, if it is allowed. Otherwise, returns nil.
+func (f *filteredView[T]) GetEdge(from, to *Vertex[T]) *Edge[T] {
+	source, dest := f.vertex(from), f.vertex(to)
+	if source == nil || dest == nil {
+		return nil
+	}
+
+	edge := f.graph.GetEdge(source, dest)
+	if !f.edgeAllowed(edge) {
+		return nil
+	}
+
+	return f.edgeHandle(edge)
+}
+
+// GetEdgeByID returns the edge with the specified ID, if it is allowed.
+func (f *filteredView[T]) GetEdgeByID(id uint64) (*Edge[T], bool) {
+	edge, ok := f.graph.GetEdgeByID(id)
+	if !ok || !f.edgeAllowed(edge) {
+		return nil, false
+	}
+
+	return f.edgeHandle(edge), true
+}
+
+// EdgesOf returns the allowed edges touching the specified vertex. If the
+// vertex is hidden, returns nil.
+func (f *filteredView[T]) EdgesOf(v *Vertex[T]) []*Edge[T] {
+	original := f.vertex(v)
+	if original == nil {
+		return nil
+	}
+
+	return f.filterEdges(f.graph.EdgesOf(original))
+}
+
+// RemoveEdges does nothing, since the view is read-only.
+func (f *filteredView[T]) RemoveEdges(_ ...*Edge[T]) {}
+
+// AddVertexByLabel returns nil, since the view is read-only.
+func (f *filteredView[T]) AddVertexByLabel(_ T, _ ...VertexOptionFunc) *Vertex[T] {
+	return nil
+}
+
+// TryAddVertex returns ErrReadOnlyGraph, since the view is read-only.
+func (f *filteredView[T]) TryAddVertex(_ T, _ ...VertexOptionFunc) (*Vertex[T], error) {
+	return nil, ErrReadOnlyGraph
+}
+
+// AddVertex does nothing, since the view is read-only.
+func (f *filteredView[T]) AddVertex(_ *Vertex[T]) {}
+
+// GetVertexByID returns the vertex with the input label, whose neighbors
+// only include the allowed edges. If the vertex doesn't exist or it is
+// hidden, returns nil.
+func (f *filteredView[T]) GetVertexByID(label T) *Vertex[T] {
+	original := f.graph.GetVertexByID(label)
+	if !f.vertexAllowed(original) {
+		return nil
+	}
+
+	return f.vertexHandle(original)
+}
+
+// GetAllVerticesByID returns the allowed vertices with the specified labels.
+func (f *filteredView[T]) GetAllVerticesByID(labels ...T) []*Vertex[T] {
+	var vertices []*Vertex[T]
+	for _, label := range labels {
+		if v := f.GetVertexByID(label); v != nil {
+			vertices = append(vertices, v)
+		}
+	}
+
+	return vertices
+}
+
+// GetAllVertices returns all the allowed vertices.
+func (f *filteredView[T]) GetAllVertices() []*Vertex[T] {
+	var vertices []*Vertex[T]
+	for _, v := range f.graph.GetAllVertices() {
+		if f.vertexAllowed(v) {
+			vertices = append(vertices, f.vertexHandle(v))
+		}
+	}
+
+	return vertices
+}
+
+// GetAllVerticesSorted returns all the allowed vertices, sorted by their
+// labels with the specified less function.
+func (f *filteredView[T]) GetAllVerticesSorted(less func(a, b T) bool) []*Vertex[T] {
+	return sortVerticesWithCmp(f.GetAllVertices(), less)
+}
+
+// ForEachVertex calls the callback function on all the allowed vertices.
+func (f *filteredView[T]) ForEachVertex(fn func(v *Vertex[T]) error) error {
+	return f.graph.ForEachVertex(func(v *Vertex[T]) error {
+		if !f.vertexAllowed(v) {
+			return nil
+		}
+
+		return fn(f.vertexHandle(v))
+	})
+}
+
+// RemoveVertices does nothing, since the view is read-only.
+func (f *filteredView[T]) RemoveVertices(_ ...*Vertex[T]) {}
+
+// RemoveVerticesByLabel returns ErrReadOnlyGraph, since the view is read-only.
+func (f *filteredView[T]) RemoveVerticesByLabel(_ ...T) error {
+	return ErrReadOnlyGraph
+}
+
+// ContainsEdge returns 'true' if the view contains an allowed edge going
+// from the source vertex to the target vertex.
+func (f *filteredView[T]) ContainsEdge(from, to *Vertex[T]) bool {
+	return f.GetEdge(from, to) != nil
+}
+
+// HasEdge returns 'true' if there is an allowed edge between the vertices
+// with the specified labels.
+func (f *filteredView[T]) HasEdge(from, to T) bool {
+	source := f.graph.GetVertexByID(from)
+	dest := f.graph.GetVertexByID(to)
+	if !f.vertexAllowed(source) || !f.vertexAllowed(dest) {
+		return false
+	}
+
+	if f.edgeAllowed(f.graph.GetEdge(source, dest)) {
+		return true
+	}
+
+	return !f.IsDirected() && f.edgeAllowed(f.graph.GetEdge(dest, source))
+}
+
+// ContainsVertex returns 'true' if the view contains the specified vertex.
+func (f *filteredView[T]) ContainsVertex(v *Vertex[T]) bool {
+	return f.vertex(v) != nil
+}
+
+// Order returns the number of allowed vertices.
+func (f *filteredView[T]) Order() uint32 {
+	var order uint32
+	for _, v := range f.graph.GetAllVertices() {
+		if f.vertexAllowed(v) {
+			order++
+		}
+	}
+
+	return order
+}
+
+// Size returns the number of allowed edges.
+func (f *filteredView[T]) Size() uint32 {
+	var size uint32
+	_ = f.graph.ForEachEdge(func(e *Edge[T]) error {
+		if f.edgeAllowed(e) {
+			size++
+		}
+		return nil
+	})
+
+	return size
+}
+
+// vertex returns the vertex of the underlying graph with the same label as
+// the input vertex, if it is allowed. Otherwise, returns nil.
+func (f *filteredView[T]) vertex(v *Vertex[T]) *Vertex[T] {
+	if v == nil {
+		return nil
+	}
+
+	original := f.graph.GetVertexByID(v.label)
+	if !f.vertexAllowed(original) {
+		return nil
+	}
+
+	return original
+}
+
+// readOnly reports that the view rejects the mutations.
+func (f *filteredView[T]) readOnly() bool {
+	return true
+}
+
+// modifications returns the number of changes of the underlying graph, if
+// it tracks them, so the views of views detect the changes too.
+func (f *filteredView[T]) modifications() uint64 {
+	if m, ok := f.graph.(interface{ modifications() uint64 }); ok {
+		return m.modifications()
+	}
+
+	return 0
+}
+
+// neighborsOf returns the allowed neighbors of the vertex with the specified
+// label, which are computed from the underlying graph on each call.
+func (f *filteredView[T]) neighborsOf(label T) []*Vertex[T] {
+	original := f.graph.GetVertexByID(label)
+	if !f.vertexAllowed(original) {
+		return nil
+	}
+
+	var neighbors []*Vertex[T]
+	for _, neighbor := range original.adjacent() {
+		if f.edgeAllowed(f.graph.GetEdge(original, neighbor)) {
+			neighbors = append(neighbors, f.vertexHandle(neighbor))
+		}
+	}
+
+	return neighbors
+}
+
+// inDegreeOf returns the number of allowed edges to the vertex with the
+// specified label. In undirected graph, it is equal to the number of its
+// neighbors, otherwise the whole graph is scanned.
+func (f *filteredView[T]) inDegreeOf(label T) int {
+	if !f.IsDirected() {
+		return len(f.neighborsOf(label))
+	}
+
+	var inDegree int
+	for _, v := range f.graph.GetAllVertices() {
+		if !f.vertexAllowed(v) {
+			continue
+		}
+
+		for _, neighbor := range v.adjacent() {
+			if neighbor.label == label && f.edgeAllowed(f.graph.GetEdge(v, neighbor)) {
+				inDegree++
+			}
+		}
+	}
+
+	return inDegree
+}
+
+// vertexHandle returns the vertex of the view for the vertex of the
+// underlying graph. The handle is created on the first call, and replaced
+// if the underlying graph has replaced the vertex.
+func (f *filteredView[T]) vertexHandle(original *Vertex[T]) *Vertex[T] {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if v, ok := f.vertices[original.label]; ok && v.handle.origin == original {
+		return v
+	}
+
+	if f.vertices == nil {
+		f.vertices = make(map[T]*Vertex[T])
+	}
+
+	v := &Vertex[T]{
+		label:      original.label,
+		properties: original.properties,
+		handle:     &viewHandle[T]{view: f, origin: original},
+	}
+	f.vertices[original.label] = v
+
+	return v
+}
+
+// edgeHandle returns the edge of the view for the edge of the underlying
+// graph, whose endpoints are the vertices of the view.
+func (f *filteredView[T]) edgeHandle(original *Edge[T]) *Edge[T] {
+	source, dest := f.vertexHandle(original.source), f.vertexHandle(original.dest)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if e, ok := f.edges[original]; ok && e.source == source && e.dest == dest {
+		return e
+	}
+
+	if f.edges == nil {
+		f.edges = make(map[*Edge[T]]*Edge[T])
+	}
+
+	e := &Edge[T]{
+		id:         original.id,
+		source:     source,
+		dest:       dest,
+		properties: original.properties,
+		origin:     original,
+	}
+	f.edges[original] = e
+
+	return e
+}
+
+func (f *filteredView[T]) vertexAllowed(v *Vertex[T]) bool {
+	return v != nil && (f.vertexAllow == nil || f.vertexAllow(v))
+}
+
+func (f *filteredView[T]) edgeAllowed(edge *Edge[T]) bool {
+	return edge != nil &&
+		f.vertexAllowed(edge.source) &&
+		f.vertexAllowed(edge.dest) &&
+		(f.edgeAllow == nil || f.edgeAllow(edge))
+}
+
+func (f *filteredView[T]) filterEdges(edges []*Edge[T]) []*Edge[T] {
+	var out []*Edge[T]
+	for _, edge := range edges {
+		if f.edgeAllowed(edge) {
+			out = append(out, f.edgeHandle(edge))
+		}
+	}
+
+	return out
+}
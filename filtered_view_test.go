@@ -0,0 +1,331 @@
+package gograph
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// initFilteredViewTestGraph creates the following directed graph, where
+// the edge B -> D is heavy.
+//
+//	A -> B -> C
+//	|    |
+//	v    v
+//	E    D -> F
+func initFilteredViewTestGraph() Graph[string] {
+	g := New[string](Directed(), Weighted())
+	edges := []struct {
+		from, to string
+		weight   float64
+	}{
+		{"A", "B", 1}, {"B", "C", 1}, {"A", "E", 1}, {"B", "D", 10}, {"D", "F", 1},
+	}
+	for _, e := range edges {
+		_, _ = g.AddEdge(NewVertex(e.from), NewVertex(e.to), WithEdgeWeight(e.weight))
+	}
+
+	return g
+}
+
+func TestFilteredView(t *testing.T) {
+	g := initFilteredViewTestGraph()
+	view := FilteredView(g,
+		func(e *Edge[string]) bool { return e.Weight() < 5 },
+		func(v *Vertex[string]) bool { return v.Label() != "E" },
+	)
+
+	if view.Order() != 5 {
+		t.Errorf(testErrMsgWrongLen, 5, view.Order())
+	}
+
+	// A -> E is hidden with E, and B -> D is hidden by its weight
+	if view.Size() != 3 {
+		t.Errorf(testErrMsgWrongLen, 3, view.Size())
+	}
+
+	if view.GetVertexByID("E") != nil || view.HasEdge("A", "E") || view.HasEdge("B", "D") {
+		t.Error("expected the hidden vertices and edges to be skipped")
+	}
+
+	if !view.HasEdge("D", "F") || !view.ContainsEdge(NewVertex("A"), NewVertex("B")) {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	// traverse the view through the vertices returned by it
+	var visited []string
+	queue := []*Vertex[string]{view.GetVertexByID("A")}
+	seen := map[string]bool{"A": true}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+		visited = append(visited, curr.Label())
+
+		for _, neighbor := range curr.Neighbors() {
+			if !seen[neighbor.Label()] {
+				seen[neighbor.Label()] = true
+				queue = append(queue, view.GetVertexByID(neighbor.Label()))
+			}
+		}
+	}
+
+	sort.Strings(visited)
+	if expected := []string{"A", "B", "C"}; !reflect.DeepEqual(visited, expected) {
+		t.Errorf(testErrMsgNotEqual, expected, visited)
+	}
+
+	// the degrees only count the allowed edges
+	if b := view.GetVertexByID("B"); b.OutDegree() != 1 || b.InDegree() != 1 {
+		t.Errorf(testErrMsgNotEqual, []int{1, 1}, []int{b.OutDegree(), b.InDegree()})
+	}
+
+	if d := view.GetVertexByID("D"); d.InDegree() != 0 {
+		t.Errorf(testErrMsgNotEqual, 0, d.InDegree())
+	}
+
+	// the vertices returned together form a consistent graph
+	sorted, err := TopologySort(view)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if len(sorted) != 5 {
+		t.Errorf(testErrMsgWrongLen, 5, len(sorted))
+	}
+
+	// the underlying graph is not copied or modified
+	if g.Order() != 6 || g.Size() != 5 || g.GetVertexByID("B").OutDegree() != 2 {
+		t.Error("expected the underlying graph to be unchanged")
+	}
+
+	// the view reflects the later changes of the underlying graph
+	_, _ = g.AddEdge(g.GetVertexByID("C"), g.GetVertexByID("F"), WithEdgeWeight(1))
+	if !view.HasEdge("C", "F") {
+		t.Error(testErrMsgNotTrue)
+	}
+}
+
+func TestFilteredViewReadOnly(t *testing.T) {
+	g := initFilteredViewTestGraph()
+	view := FilteredView[string](g, nil, nil)
+
+	if _, err := view.AddEdge(NewVertex("X"), NewVertex("Y")); !errors.Is(err, ErrReadOnlyGraph) {
+		t.Errorf(testErrMsgNotEqual, ErrReadOnlyGraph, err)
+	}
+
+	if _, _, err := view.GetOrAddEdge(NewVertex("X"), NewVertex("Y")); !errors.Is(err, ErrReadOnlyGraph) {
+		t.Errorf(testErrMsgNotEqual, ErrReadOnlyGraph, err)
+	}
+
+	if _, err := view.TryAddVertex("X"); !errors.Is(err, ErrReadOnlyGraph) {
+		t.Errorf(testErrMsgNotEqual, ErrReadOnlyGraph, err)
+	}
+
+	if err := view.RemoveVerticesByLabel("A"); !errors.Is(err, ErrReadOnlyGraph) {
+		t.Errorf(testErrMsgNotEqual, ErrReadOnlyGraph, err)
+	}
+
+	if v := view.AddVertexByLabel("X"); v != nil {
+		t.Errorf(testErrMsgNotEqual, nil, v)
+	}
+
+	view.AddVertex(NewVertex("X"))
+	view.RemoveVertices(g.GetVertexByID("A"))
+	view.RemoveEdges(g.AllEdges()...)
+
+	if g.Order() != 6 || g.Size() != 5 || view.Order() != 6 || view.Size() != 5 {
+		t.Error("expected the mutations of the view to be ignored")
+	}
+}
+
+func TestFilteredViewForEach(t *testing.T) {
+	g := initFilteredViewTestGraph()
+	view := FilteredView(g,
+		func(e *Edge[string]) bool { return e.Weight() < 5 },
+		func(v *Vertex[string]) bool { return v.Label() != "E" },
+	)
+
+	var vertices, edges int
+	_ = view.ForEachVertex(func(v *Vertex[string]) error {
+		if v.Label() == "E" {
+			t.Error("Expected the hidden vertex to be skipped")
+		}
+		vertices++
+		return nil
+	})
+
+	_ = view.ForEachEdge(func(e *Edge[string]) error {
+		if e.Weight() >= 5 || e.Destination().Label() == "E" {
+			t.Error("Expected the hidden edges to be skipped")
+		}
+		edges++
+		return nil
+	})
+
+	if vertices != 5 || edges != 3 {
+		t.Errorf("Expected 5 vertices and 3 edges, got %d and %d", vertices, edges)
+	}
+}
+
+func TestFilteredViewGetEdgeByID(t *testing.T) {
+	g := initFilteredViewTestGraph()
+	view := FilteredView(g, func(e *Edge[string]) bool { return e.Weight() < 5 }, nil)
+
+	var allowed, hidden int
+	_ = g.ForEachEdge(func(e *Edge[string]) error {
+		edge, ok := view.GetEdgeByID(e.ID())
+		switch {
+		case e.Weight() < 5 && (!ok || edge.ID() != e.ID() || edge.Weight() != e.Weight()):
+			t.Errorf("Expected the allowed edge %d to be found", e.ID())
+		case ok && edge.Source() != view.GetVertexByID(e.Source().Label()):
+			t.Errorf("Expected the source of edge %d to be the vertex of the view", e.ID())
+		case e.Weight() >= 5 && ok:
+			t.Errorf("Expected the hidden edge %d to be missing", e.ID())
+		}
+
+		if ok {
+			allowed++
+		} else {
+			hidden++
+		}
+		return nil
+	})
+
+	if allowed == 0 || hidden == 0 {
+		t.Errorf("Expected both allowed and hidden edges, got %d and %d", allowed, hidden)
+	}
+}
+
+func TestFilteredViewGetAllVerticesSorted(t *testing.T) {
+	view := FilteredView(initFilteredViewTestGraph(), nil, func(v *Vertex[string]) bool { return v.Label() != "E" })
+
+	labels := extractLabels(view.GetAllVerticesSorted(func(a, b string) bool { return a < b }))
+	expected := []string{"A", "B", "C", "D", "F"}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Errorf(testErrMsgNotEqual, expected, labels)
+	}
+}
+
+func TestFilteredViewNeighbors(t *testing.T) {
+	g := initFilteredViewTestGraph()
+	view := FilteredView(g,
+		func(e *Edge[string]) bool { return e.Weight() < 5 },
+		func(v *Vertex[string]) bool { return v.Label() != "E" },
+	)
+
+	// the neighbors of a vertex skip the hidden vertices and edges, and so
+	// do the neighbors of its neighbors
+	a := view.GetVertexByID("A")
+	if labels := extractLabels(a.Neighbors()); !reflect.DeepEqual(labels, []string{"B"}) {
+		t.Errorf(testErrMsgNotEqual, []string{"B"}, labels)
+	}
+
+	neighbor := a.Neighbors()[0]
+	if labels := extractLabels(neighbor.Neighbors()); !reflect.DeepEqual(labels, []string{"C"}) {
+		t.Errorf(testErrMsgNotEqual, []string{"C"}, labels)
+	}
+
+	if neighbor.OutDegree() != 1 {
+		t.Errorf(testErrMsgNotEqual, 1, neighbor.OutDegree())
+	}
+
+	b := view.GetVertexByID("B")
+
+	// the view returns the same vertex for the same label
+	if view.GetVertexByID("B") != b {
+		t.Error("expected the vertex to be reused")
+	}
+
+	_, _ = g.AddEdge(g.GetVertexByID("B"), g.GetVertexByID("F"), WithEdgeWeight(1))
+	b = view.GetVertexByID("B")
+	if labels := extractLabels(b.Neighbors()); !reflect.DeepEqual(labels, []string{"C", "F"}) {
+		t.Errorf(testErrMsgNotEqual, []string{"C", "F"}, labels)
+	}
+
+	if f := view.GetVertexByID("F"); f.InDegree() != 2 {
+		t.Errorf(testErrMsgNotEqual, 2, f.InDegree())
+	}
+}
+
+func TestFilteredViewEdgesStayInView(t *testing.T) {
+	g := initFilteredViewTestGraph()
+	view := FilteredView(g,
+		func(e *Edge[string]) bool { return e.Weight() < 5 },
+		func(v *Vertex[string]) bool { return v.Label() != "C" },
+	)
+
+	// the endpoints of the returned edges are the vertices of the view, so
+	// walking from them skips the hidden vertices and edges
+	edge := view.GetEdge(view.GetVertexByID("A"), view.GetVertexByID("B"))
+	if edge == nil {
+		t.Fatal("Expected the edge A -> B to be found")
+	}
+
+	if labels := extractLabels(edge.Destination().Neighbors()); len(labels) != 0 {
+		t.Errorf(testErrMsgWrongLen, 0, len(labels))
+	}
+
+	for _, e := range view.EdgesOf(view.GetVertexByID("B")) {
+		if e.Source() != view.GetVertexByID(e.Source().Label()) {
+			t.Errorf("Expected the source of %v to be the vertex of the view", e)
+		}
+	}
+
+	for _, e := range view.AllEdges() {
+		if e.Destination().Label() == "C" || e.Destination().Label() == "D" {
+			t.Errorf("Expected the hidden edge to %s to be skipped", e.Destination().Label())
+		}
+	}
+}
+
+func TestFilteredViewReflectsChanges(t *testing.T) {
+	g := initFilteredViewTestGraph()
+	hidden := map[string]bool{"C": true}
+	view := FilteredView(g, func(e *Edge[string]) bool { return e.Weight() < 5 },
+		func(v *Vertex[string]) bool { return !hidden[v.Label()] },
+	)
+
+	b := view.GetVertexByID("B")
+	if b.OutDegree() != 0 {
+		t.Errorf(testErrMsgNotEqual, 0, b.OutDegree())
+	}
+
+	// the state of the predicates is read on each call
+	delete(hidden, "C")
+	if labels := extractLabels(b.Neighbors()); !reflect.DeepEqual(labels, []string{"C"}) {
+		t.Errorf(testErrMsgNotEqual, []string{"C"}, labels)
+	}
+
+	// so are the weights of the edges
+	g.GetEdge(g.GetVertexByID("B"), g.GetVertexByID("D")).properties.weight = 1
+	if labels := extractLabels(b.Neighbors()); !reflect.DeepEqual(labels, []string{"C", "D"}) {
+		t.Errorf(testErrMsgNotEqual, []string{"C", "D"}, labels)
+	}
+
+	if edge := view.GetEdge(b, view.GetVertexByID("D")); edge == nil || edge.Weight() != 1 {
+		t.Errorf("Expected the edge B -> D to be allowed with weight 1, got %v", edge)
+	}
+}
+
+func TestFilteredViewOfView(t *testing.T) {
+	g := initFilteredViewTestGraph()
+	inner := FilteredView(g, nil, func(v *Vertex[string]) bool { return v.Label() != "E" })
+	view := FilteredView(inner, func(e *Edge[string]) bool { return e.Weight() < 5 }, nil)
+
+	// swap an edge, so the number of vertices and edges stays the same
+	g.RemoveEdges(g.GetEdge(g.GetVertexByID("B"), g.GetVertexByID("C")))
+	_, _ = g.AddEdge(g.GetVertexByID("A"), g.GetVertexByID("C"), WithEdgeWeight(1))
+
+	if labels := extractLabels(view.GetVertexByID("A").Neighbors()); !reflect.DeepEqual(labels, []string{"B", "C"}) {
+		t.Errorf(testErrMsgNotEqual, []string{"B", "C"}, labels)
+	}
+
+	if b := view.GetVertexByID("B"); b.OutDegree() != 0 {
+		t.Errorf(testErrMsgNotEqual, 0, b.OutDegree())
+	}
+
+	if view.Order() != 5 || view.Size() != 3 {
+		t.Errorf("Expected 5 vertices and 3 edges, got %d and %d", view.Order(), view.Size())
+	}
+}
@@ -0,0 +1,132 @@
+package flow
+
+import (
+	"errors"
+	"math"
+
+	"github.com/gavinhailey/gograph"
+)
+
+var (
+	ErrSourceIsSink = errors.New("source and sink are the same vertex")
+)
+
+// MaxFlow computes the maximum flow from the source vertex to the sink
+// vertex using the Edmonds-Karp algorithm, which repeatedly augments the
+// flow along the shortest path in the residual network found by a BFS.
+//
+// In weighted graph, the edge weights are the capacities of the edges, and
+// an edge with positive infinity weight has unlimited capacity. In unweighted
+// graph, every edge has a unit capacity. In undirected graph, each edge can
+// carry flow in both directions up to its capacity.
+//
+// The time complexity of the Edmonds-Karp algorithm is O(V*E^2).
+//
+// It returns ErrVertexDoesNotExist if any of the vertices doesn't exist, and
+// ErrSourceIsSink if the source and the sink are the same vertex.
+func MaxFlow[T comparable](g gograph.Graph[T], source, sink T) (float64, error) {
+	if g.GetVertexByID(source) == nil || g.GetVertexByID(sink) == nil {
+		return 0, gograph.ErrVertexDoesNotExist
+	}
+
+	if source == sink {
+		return 0, ErrSourceIsSink
+	}
+
+	return newResidualNetwork(g).maxFlow(source, sink), nil
+}
+
+// residualNetwork stores the remaining capacity of each edge, including the
+// reverse edges that let the algorithm cancel the flow that was already sent.
+type residualNetwork[T comparable] struct {
+	capacity map[T]map[T]float64
+}
+
+func newResidualNetwork[T comparable](g gograph.Graph[T]) *residualNetwork[T] {
+	r := &residualNetwork[T]{capacity: make(map[T]map[T]float64)}
+	for _, edge := range g.AllEdges() {
+		capacity := 1.0
+		if g.IsWeighted() {
+			capacity = edge.Weight()
+		}
+
+		r.add(edge.Source().Label(), edge.Destination().Label(), capacity)
+	}
+
+	return r
+}
+
+// add adds the capacity to the edge from u to v, and makes sure the reverse
+// edge exists in the network.
+func (r *residualNetwork[T]) add(u, v T, capacity float64) {
+	if _, ok := r.capacity[u]; !ok {
+		r.capacity[u] = make(map[T]float64)
+	}
+
+	if _, ok := r.capacity[v]; !ok {
+		r.capacity[v] = make(map[T]float64)
+	}
+
+	r.capacity[u][v] += capacity
+	if _, ok := r.capacity[v][u]; !ok {
+		r.capacity[v][u] = 0
+	}
+}
+
+// maxFlow augments the flow along the shortest paths until the sink is no
+// longer reachable from the source, and returns the total flow.
+func (r *residualNetwork[T]) maxFlow(source, sink T) float64 {
+	var total float64
+	for {
+		parents := r.shortestPath(source, sink)
+		if parents == nil {
+			return total
+		}
+
+		// find the bottleneck capacity of the path
+		bottleneck := math.Inf(1)
+		for v := sink; v != source; v = parents[v] {
+			bottleneck = math.Min(bottleneck, r.capacity[parents[v]][v])
+		}
+
+		if math.IsInf(bottleneck, 1) {
+			return bottleneck
+		}
+
+		for v := sink; v != source; v = parents[v] {
+			r.capacity[parents[v]][v] -= bottleneck
+			r.capacity[v][parents[v]] += bottleneck
+		}
+
+		total += bottleneck
+	}
+}
+
+// shortestPath finds the shortest path with remaining capacity from the
+// source to the sink using BFS. It returns the parent of each vertex on the
+// path, or nil if the sink is not reachable.
+func (r *residualNetwork[T]) shortestPath(source, sink T) map[T]T {
+	parents := make(map[T]T)
+	visited := map[T]bool{source: true}
+	queue := []T{source}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+
+		for v, capacity := range r.capacity[u] {
+			if visited[v] || capacity <= 0 {
+				continue
+			}
+
+			visited[v] = true
+			parents[v] = u
+			if v == sink {
+				return parents
+			}
+
+			queue = append(queue, v)
+		}
+	}
+
+	return nil
+}
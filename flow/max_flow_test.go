@@ -0,0 +1,84 @@
+package flow
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestMaxFlow(t *testing.T) {
+	// the classic CLRS flow network
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+
+	edges := []struct {
+		from, to string
+		capacity float64
+	}{
+		{"s", "v1", 16}, {"s", "v2", 13},
+		{"v1", "v3", 12}, {"v2", "v1", 4},
+		{"v2", "v4", 14}, {"v3", "v2", 9},
+		{"v3", "t", 20}, {"v4", "v3", 7},
+		{"v4", "t", 4},
+	}
+
+	for _, e := range edges {
+		from := g.AddVertexByLabel(e.from)
+		if from == nil {
+			from = g.GetVertexByID(e.from)
+		}
+
+		to := g.AddVertexByLabel(e.to)
+		if to == nil {
+			to = g.GetVertexByID(e.to)
+		}
+
+		_, _ = g.AddEdge(from, to, gograph.WithEdgeWeight(e.capacity))
+	}
+
+	flow, err := MaxFlow(g, "s", "t")
+	if err != nil {
+		t.Errorf("Expected no error, but got %s", err)
+	}
+
+	if flow != 23 {
+		t.Errorf("Expected max flow 23, but got %f", flow)
+	}
+
+	// there is no path from t to s
+	flow, _ = MaxFlow(g, "t", "s")
+	if flow != 0 {
+		t.Errorf("Expected max flow 0, but got %f", flow)
+	}
+
+	_, err = MaxFlow(g, "s", "x")
+	if !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	_, err = MaxFlow(g, "s", "s")
+	if !errors.Is(err, ErrSourceIsSink) {
+		t.Errorf("Expected error %s, but got %v", ErrSourceIsSink, err)
+	}
+}
+
+func TestMaxFlowUnweighted(t *testing.T) {
+	// every edge has a unit capacity
+	//	1 - 2 - 4
+	//	 \     /
+	//	  - 3 -
+	g := gograph.New[int]()
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	_, _ = g.AddEdge(g.GetVertexByID(2), gograph.NewVertex(4))
+	_, _ = g.AddEdge(g.GetVertexByID(1), gograph.NewVertex(3))
+	_, _ = g.AddEdge(g.GetVertexByID(3), g.GetVertexByID(4))
+
+	flow, err := MaxFlow(g, 4, 1)
+	if err != nil {
+		t.Errorf("Expected no error, but got %s", err)
+	}
+
+	if flow != 2 {
+		t.Errorf("Expected max flow 2, but got %f", flow)
+	}
+}
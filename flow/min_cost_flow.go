@@ -0,0 +1,204 @@
+package flow
+
+import (
+	"errors"
+	"math"
+
+	"github.com/gavinhailey/gograph"
+)
+
+var (
+	ErrNegativeCostCycle = errors.New("graph has a negative cost cycle")
+)
+
+// MinCostMaxFlow computes the maximum flow from the source vertex to the
+// sink vertex, that has the minimum total cost among all the maximum flows.
+// It is the solution of transportation problems, where the goods have to
+// be shipped through routes with limited capacity and a cost per unit.
+//
+// The capacity and the cost of the edges are set by WithEdgeCapacityCost.
+// As in MaxFlow, the edge weights are the capacities in weighted graph, and
+// every edge has a unit capacity in unweighted graph. In undirected graph,
+// each edge can carry flow in both directions at the same cost.
+//
+// It uses the successive shortest paths algorithm, which augments the flow
+// along the cheapest path in the residual network found by Bellman-Ford,
+// so the costs may be negative. The time complexity is O(F*V*E), where F is
+// the number of augmentations. If the cheapest path has unlimited capacity,
+// the flow is positive infinity.
+//
+// It returns ErrVertexDoesNotExist if any of the vertices doesn't exist,
+// ErrSourceIsSink if the source and the sink are the same vertex, and
+// ErrNegativeCostCycle if the graph has a cycle with negative total cost,
+// since its cost would be unbounded.
+func MinCostMaxFlow[T comparable](g gograph.Graph[T], source, sink T) (flow, cost float64, err error) {
+	if g.GetVertexByID(source) == nil || g.GetVertexByID(sink) == nil {
+		return 0, 0, gograph.ErrVertexDoesNotExist
+	}
+
+	if source == sink {
+		return 0, 0, ErrSourceIsSink
+	}
+
+	r := newCostNetwork(g)
+	if r.hasNegativeCycle() {
+		return 0, 0, ErrNegativeCostCycle
+	}
+
+	flow, cost = r.minCostMaxFlow(source, sink)
+
+	return flow, cost, nil
+}
+
+// costArc is an arc of the residual network. Each edge of the graph has an
+// arc, and a reverse arc with no capacity and the negated cost, that lets
+// the algorithm cancel the flow that was already sent.
+type costArc[T comparable] struct {
+	to       T
+	capacity float64
+	cost     float64
+	reverse  int // index of the reverse arc in the arcs slice
+}
+
+// costNetwork is the residual network of MinCostMaxFlow. Unlike the one of
+// MaxFlow, it keeps the parallel arcs apart, since they can have different
+// costs.
+type costNetwork[T comparable] struct {
+	vertices []T
+	arcs     []costArc[T]
+	out      map[T][]int
+}
+
+func newCostNetwork[T comparable](g gograph.Graph[T]) *costNetwork[T] {
+	r := &costNetwork[T]{out: make(map[T][]int)}
+	for _, v := range g.GetAllVertices() {
+		r.vertices = append(r.vertices, v.Label())
+	}
+
+	for _, edge := range g.AllEdges() {
+		capacity := 1.0
+		if g.IsWeighted() {
+			capacity = edge.Weight()
+		}
+
+		r.add(edge.Source().Label(), edge.Destination().Label(), capacity, edge.Cost())
+	}
+
+	return r
+}
+
+// add adds the arc from u to v and its reverse arc to the network.
+func (r *costNetwork[T]) add(u, v T, capacity, cost float64) {
+	i := len(r.arcs)
+	r.arcs = append(r.arcs,
+		costArc[T]{to: v, capacity: capacity, cost: cost, reverse: i + 1},
+		costArc[T]{to: u, capacity: 0, cost: -cost, reverse: i},
+	)
+
+	r.out[u] = append(r.out[u], i)
+	r.out[v] = append(r.out[v], i+1)
+}
+
+// hasNegativeCycle runs Bellman-Ford from all the vertices at once, and
+// reports whether the arcs with capacity form a negative cost cycle.
+func (r *costNetwork[T]) hasNegativeCycle() bool {
+	dist := make(map[T]float64, len(r.vertices))
+	for _, v := range r.vertices {
+		dist[v] = 0
+	}
+
+	for i := 0; i < len(r.vertices); i++ {
+		if !r.relax(dist, nil) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// relax relaxes all the arcs with remaining capacity once, and reports
+// whether any distance changed. If parents is not nil, it records the arc
+// that each improved vertex was reached through.
+func (r *costNetwork[T]) relax(dist map[T]float64, parents map[T]int) bool {
+	changed := false
+	for _, u := range r.vertices {
+		du, ok := dist[u]
+		if !ok {
+			continue
+		}
+
+		for _, i := range r.out[u] {
+			arc := r.arcs[i]
+			if arc.capacity <= 0 {
+				continue
+			}
+
+			if dv, ok := dist[arc.to]; !ok || du+arc.cost < dv {
+				dist[arc.to] = du + arc.cost
+				if parents != nil {
+					parents[arc.to] = i
+				}
+				changed = true
+			}
+		}
+	}
+
+	return changed
+}
+
+// cheapestPath finds the cheapest path with remaining capacity from the
+// source to the sink using Bellman-Ford. It returns the arc that each vertex
+// on the path is reached through, and the cost of the path, or nil if the
+// sink is not reachable.
+func (r *costNetwork[T]) cheapestPath(source, sink T) (map[T]int, float64) {
+	dist := map[T]float64{source: 0}
+	parents := make(map[T]int)
+	for i := 1; i < len(r.vertices); i++ {
+		if !r.relax(dist, parents) {
+			break
+		}
+	}
+
+	if _, ok := dist[sink]; !ok {
+		return nil, 0
+	}
+
+	return parents, dist[sink]
+}
+
+// minCostMaxFlow augments the flow along the cheapest paths until the sink
+// is no longer reachable from the source, and returns the total flow and
+// its cost.
+func (r *costNetwork[T]) minCostMaxFlow(source, sink T) (flow, cost float64) {
+	for {
+		parents, pathCost := r.cheapestPath(source, sink)
+		if parents == nil {
+			return flow, cost
+		}
+
+		// find the bottleneck capacity of the path
+		bottleneck := math.Inf(1)
+		for v := sink; v != source; {
+			arc := r.arcs[parents[v]]
+			bottleneck = math.Min(bottleneck, arc.capacity)
+			v = r.arcs[arc.reverse].to
+		}
+
+		if math.IsInf(bottleneck, 1) {
+			if pathCost != 0 {
+				cost = math.Copysign(bottleneck, pathCost)
+			}
+			return bottleneck, cost
+		}
+
+		for v := sink; v != source; {
+			i := parents[v]
+			r.arcs[i].capacity -= bottleneck
+			r.arcs[r.arcs[i].reverse].capacity += bottleneck
+			v = r.arcs[r.arcs[i].reverse].to
+		}
+
+		flow += bottleneck
+		cost += bottleneck * pathCost
+	}
+}
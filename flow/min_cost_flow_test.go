@@ -0,0 +1,120 @@
+package flow
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func newCostNetworkGraph(edges []struct {
+	from, to       string
+	capacity, cost float64
+}) gograph.Graph[string] {
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	for _, e := range edges {
+		_, _ = g.AddEdge(gograph.NewVertex(e.from), gograph.NewVertex(e.to), gograph.WithEdgeCapacityCost(e.capacity, e.cost))
+	}
+
+	return g
+}
+
+func TestMinCostMaxFlow(t *testing.T) {
+	// the cheapest routes s-a-b-t and s-b-t share b->t, so two units have
+	// to take the expensive route s-a-t
+	g := newCostNetworkGraph([]struct {
+		from, to       string
+		capacity, cost float64
+	}{
+		{"s", "a", 3, 1}, {"s", "b", 2, 2},
+		{"a", "b", 2, 1}, {"a", "t", 2, 3},
+		{"b", "t", 3, 1},
+	})
+
+	flow, cost, err := MinCostMaxFlow(g, "s", "t")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if flow != 5 || cost != 17 {
+		t.Errorf("Expected flow 5 with cost 17, but got %f with cost %f", flow, cost)
+	}
+
+	// the flow is the same as MaxFlow
+	maxFlow, _ := MaxFlow(g, "s", "t")
+	if flow != maxFlow {
+		t.Errorf("Expected max flow %f, but got %f", maxFlow, flow)
+	}
+
+	// there is no path from t to s
+	flow, cost, _ = MinCostMaxFlow(g, "t", "s")
+	if flow != 0 || cost != 0 {
+		t.Errorf("Expected flow 0 with cost 0, but got %f with cost %f", flow, cost)
+	}
+}
+
+func TestMinCostMaxFlow_Transportation(t *testing.T) {
+	// two warehouses supply two stores, the shipping costs are per unit
+	g := newCostNetworkGraph([]struct {
+		from, to       string
+		capacity, cost float64
+	}{
+		{"s", "w1", 20, 0}, {"s", "w2", 30, 0},
+		{"w1", "r1", math.Inf(1), 8}, {"w1", "r2", math.Inf(1), 6},
+		{"w2", "r1", math.Inf(1), 5}, {"w2", "r2", math.Inf(1), 9},
+		{"r1", "t", 25, 0}, {"r2", "t", 25, 0},
+	})
+
+	// w1 ships 20 to r2, w2 ships 25 to r1 and 5 to r2
+	flow, cost, err := MinCostMaxFlow(g, "s", "t")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if flow != 50 || cost != 20*6+25*5+5*9 {
+		t.Errorf("Expected flow 50 with cost %d, but got %f with cost %f", 20*6+25*5+5*9, flow, cost)
+	}
+}
+
+func TestMinCostMaxFlow_NegativeCost(t *testing.T) {
+	// a negative cost makes the longer route cheaper
+	g := newCostNetworkGraph([]struct {
+		from, to       string
+		capacity, cost float64
+	}{
+		{"s", "t", 1, 1},
+		{"s", "a", 1, 1}, {"a", "b", 1, -3}, {"b", "t", 1, 1},
+	})
+
+	flow, cost, err := MinCostMaxFlow(g, "s", "t")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if flow != 2 || cost != 0 {
+		t.Errorf("Expected flow 2 with cost 0, but got %f with cost %f", flow, cost)
+	}
+
+	_, _ = g.AddEdge(g.GetVertexByID("b"), g.GetVertexByID("a"), gograph.WithEdgeCapacityCost(1, 2))
+	if _, _, err = MinCostMaxFlow(g, "s", "t"); !errors.Is(err, ErrNegativeCostCycle) {
+		t.Errorf("Expected error %s, but got %v", ErrNegativeCostCycle, err)
+	}
+}
+
+func TestMinCostMaxFlow_Errors(t *testing.T) {
+	g := newCostNetworkGraph([]struct {
+		from, to       string
+		capacity, cost float64
+	}{
+		{"s", "t", 1, 1},
+	})
+
+	if _, _, err := MinCostMaxFlow(g, "s", "x"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if _, _, err := MinCostMaxFlow(g, "s", "s"); !errors.Is(err, ErrSourceIsSink) {
+		t.Errorf("Expected error %s, but got %v", ErrSourceIsSink, err)
+	}
+}
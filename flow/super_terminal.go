@@ -0,0 +1,97 @@
+package flow
+
+import (
+	"math"
+
+	"github.com/gavinhailey/gograph"
+)
+
+var (
+	ErrVertexAlreadyExists = gograph.ErrVertexAlreadyExists
+)
+
+// AddSuperSource returns an augmented copy of the graph with a new vertex,
+// that is connected to all the source vertices by infinite-capacity edges.
+// The maximum flow from the new vertex is the maximum total flow from all
+// the sources, so MaxFlow can solve the multi-source case.
+//
+// The returned graph is always weighted, since the edge weights are the
+// capacities. If the input graph is unweighted, its edges get a unit weight,
+// which is their capacity in MaxFlow. The input graph is not modified.
+//
+// It returns ErrVertexDoesNotExist if any of the sources doesn't exist, and
+// ErrVertexAlreadyExists if the new label already exists in the graph.
+func AddSuperSource[T comparable](g gograph.Graph[T], sources []T, newLabel T) (gograph.Graph[T], error) {
+	return addSuperTerminal(g, sources, newLabel, true)
+}
+
+// AddSuperSink returns an augmented copy of the graph with a new vertex, that
+// all the sink vertices are connected to by infinite-capacity edges. The
+// maximum flow to the new vertex is the maximum total flow to all the sinks,
+// so MaxFlow can solve the multi-sink case.
+//
+// The returned graph is always weighted, since the edge weights are the
+// capacities. If the input graph is unweighted, its edges get a unit weight,
+// which is their capacity in MaxFlow. The input graph is not modified.
+//
+// It returns ErrVertexDoesNotExist if any of the sinks doesn't exist, and
+// ErrVertexAlreadyExists if the new label already exists in the graph.
+func AddSuperSink[T comparable](g gograph.Graph[T], sinks []T, newLabel T) (gograph.Graph[T], error) {
+	return addSuperTerminal(g, sinks, newLabel, false)
+}
+
+// addSuperTerminal copies the graph and connects the new vertex to the
+// terminals if outgoing is true, or the terminals to the new vertex otherwise.
+func addSuperTerminal[T comparable](
+	g gograph.Graph[T],
+	terminals []T,
+	newLabel T,
+	outgoing bool,
+) (gograph.Graph[T], error) {
+	for _, label := range terminals {
+		if g.GetVertexByID(label) == nil {
+			return nil, gograph.ErrVertexDoesNotExist
+		}
+	}
+
+	if g.GetVertexByID(newLabel) != nil {
+		return nil, ErrVertexAlreadyExists
+	}
+
+	options := []gograph.GraphOptionFunc{gograph.Weighted()}
+	if g.IsDirected() {
+		options = append(options, gograph.Directed())
+	}
+
+	augmented := gograph.New[T](options...)
+	for _, v := range g.GetAllVertices() {
+		augmented.AddVertexByLabel(v.Label(), gograph.WithVertexWeight(v.Weight()))
+	}
+
+	// undirected edges are stored in both directions, so the second
+	// direction already exists when it is added.
+	for _, edge := range g.AllEdges() {
+		capacity := 1.0
+		if g.IsWeighted() {
+			capacity = edge.Weight()
+		}
+
+		_, _ = augmented.AddEdge(
+			augmented.GetVertexByID(edge.Source().Label()),
+			augmented.GetVertexByID(edge.Destination().Label()),
+			gograph.WithEdgeWeight(capacity),
+		)
+	}
+
+	terminal := augmented.AddVertexByLabel(newLabel)
+	for _, label := range terminals {
+		from, to := terminal, augmented.GetVertexByID(label)
+		if !outgoing {
+			from, to = to, from
+		}
+
+		_, _ = augmented.AddEdge(from, to, gograph.WithEdgeWeight(math.Inf(1)))
+	}
+
+	return augmented, nil
+}
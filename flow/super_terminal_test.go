@@ -0,0 +1,83 @@
+package flow
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestAddSuperSource(t *testing.T) {
+	// two sources s1 and s2 share the bottleneck a -> t, and s2 also has
+	// its own path to t through b.
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	edges := []struct {
+		from, to string
+		capacity float64
+	}{
+		{"s1", "a", 5}, {"s2", "a", 4}, {"a", "t", 6},
+		{"s2", "b", 3}, {"b", "t", 2},
+	}
+	for _, e := range edges {
+		_, _ = g.AddEdge(gograph.NewVertex(e.from), gograph.NewVertex(e.to), gograph.WithEdgeWeight(e.capacity))
+	}
+
+	augmented, err := AddSuperSource(g, []string{"s1", "s2"}, "S")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	flow, err := MaxFlow(augmented, "S", "t")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the total flow is limited by a -> t and b -> t
+	if flow != 8 {
+		t.Errorf("expected total flow 8, got %v", flow)
+	}
+
+	// the same network with an explicit single source
+	single := gograph.Clone(g)
+	_, _ = single.AddEdge(gograph.NewVertex("S"), single.GetVertexByID("s1"), gograph.WithEdgeWeight(100))
+	_, _ = single.AddEdge(single.GetVertexByID("S"), single.GetVertexByID("s2"), gograph.WithEdgeWeight(100))
+	expected, _ := MaxFlow(single, "S", "t")
+	if flow != expected {
+		t.Errorf("expected multi-source flow %v to be equal to the single-source flow %v", flow, expected)
+	}
+
+	// the input graph is untouched
+	if g.GetVertexByID("S") != nil {
+		t.Error("expected the input graph to be unchanged")
+	}
+}
+
+func TestAddSuperSink(t *testing.T) {
+	// an unweighted graph, where each edge has a unit capacity
+	g := gograph.New[int](gograph.Directed())
+	for _, e := range [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 4}, {2, 4}, {3, 5}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	augmented, err := AddSuperSink(g, []int{4, 5}, 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	flow, err := MaxFlow(augmented, 0, 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if flow != 3 {
+		t.Errorf("expected total flow 3, got %v", flow)
+	}
+
+	if _, err = AddSuperSink(g, []int{4, 6}, 100); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %v, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if _, err = AddSuperSink(g, []int{4}, 5); !errors.Is(err, ErrVertexAlreadyExists) {
+		t.Errorf("expected error %v, got %v", ErrVertexAlreadyExists, err)
+	}
+}
@@ -0,0 +1,157 @@
+package generate
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gavinhailey/gograph"
+)
+
+var (
+	// ErrInvalidSize is returned when the size of the requested graph is
+	// not valid, e.g., it is negative.
+	ErrInvalidSize = errors.New("invalid graph size")
+)
+
+// GenerateGrid generates a rows×cols lattice, in which the vertices are
+// labeled "r,c" and each vertex is connected to its right and bottom
+// neighbors. It has rows*(cols-1) + cols*(rows-1) edges.
+//
+// It accepts the graph options such as Directed and Weighted. In directed
+// graph, the edges go right and down. In weighted graph, all the edges have
+// a unit weight.
+//
+// It returns ErrInvalidSize if rows or cols is negative.
+func GenerateGrid(rows, cols int, options ...gograph.GraphOptionFunc) (gograph.Graph[string], error) {
+	if rows < 0 || cols < 0 {
+		return nil, ErrInvalidSize
+	}
+
+	g := gograph.New[string](options...)
+	label := func(r, c int) string {
+		return strconv.Itoa(r) + "," + strconv.Itoa(c)
+	}
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			g.AddVertexByLabel(label(r, c))
+		}
+	}
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if c+1 < cols {
+				if err := addEdge(g, label(r, c), label(r, c+1)); err != nil {
+					return nil, err
+				}
+			}
+
+			if r+1 < rows {
+				if err := addEdge(g, label(r, c), label(r+1, c)); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// GenerateComplete generates a complete graph with n vertices labeled from 0
+// to n-1, in which every pair of vertices is connected. It has n*(n-1)/2 edges.
+//
+// It accepts the graph options such as Directed and Weighted. In directed
+// graph, the edges go from the lower label to the higher one. In weighted
+// graph, all the edges have a unit weight.
+//
+// It returns ErrInvalidSize if n is negative.
+func GenerateComplete(n int, options ...gograph.GraphOptionFunc) (gograph.Graph[int], error) {
+	g, err := newIntGraph(n, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if err = addEdge(g, i, j); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// GenerateCycle generates a cycle with n vertices labeled from 0 to n-1, in
+// which each vertex is connected to the next one, and the last vertex is
+// connected to the first one. It has n edges.
+//
+// It accepts the graph options such as Directed and Weighted. In weighted
+// graph, all the edges have a unit weight.
+//
+// It returns ErrInvalidSize if n is less than 3, and ErrDAGCycle if the
+// graph options include Acyclic.
+func GenerateCycle(n int, options ...gograph.GraphOptionFunc) (gograph.Graph[int], error) {
+	if n < 3 {
+		return nil, ErrInvalidSize
+	}
+
+	g, err := GeneratePath(n, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = addEdge(g, n-1, 0); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// GeneratePath generates a path with n vertices labeled from 0 to n-1, in
+// which each vertex is connected to the next one. It has n-1 edges.
+//
+// It accepts the graph options such as Directed and Weighted. In weighted
+// graph, all the edges have a unit weight.
+//
+// It returns ErrInvalidSize if n is negative.
+func GeneratePath(n int, options ...gograph.GraphOptionFunc) (gograph.Graph[int], error) {
+	g, err := newIntGraph(n, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i+1 < n; i++ {
+		if err = addEdge(g, i, i+1); err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+// newIntGraph creates a graph with n vertices labeled from 0 to n-1.
+func newIntGraph(n int, options ...gograph.GraphOptionFunc) (gograph.Graph[int], error) {
+	if n < 0 {
+		return nil, ErrInvalidSize
+	}
+
+	g := gograph.New[int](options...)
+	for i := 0; i < n; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	return g, nil
+}
+
+// addEdge adds an edge between the existing vertices with the specified
+// labels, with a unit weight in weighted graphs.
+func addEdge[T comparable](g gograph.Graph[T], from, to T) error {
+	var options []gograph.EdgeOptionFunc
+	if g.IsWeighted() {
+		options = append(options, gograph.WithEdgeWeight(1))
+	}
+
+	_, err := g.AddEdge(g.GetVertexByID(from), g.GetVertexByID(to), options...)
+	return err
+}
@@ -0,0 +1,96 @@
+package generate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// edgeCount returns the number of edges, counting each undirected edge once.
+func edgeCount[T comparable](g gograph.Graph[T]) int {
+	return gograph.Stats(g).EdgeCount
+}
+
+func TestGenerateGrid(t *testing.T) {
+	g, err := GenerateGrid(3, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if g.Order() != 12 {
+		t.Errorf("expected 12 vertices, got %d", g.Order())
+	}
+
+	// 3 rows of 3 horizontal edges, and 4 columns of 2 vertical edges
+	if got := edgeCount(g); got != 17 {
+		t.Errorf("expected 17 edges, got %d", got)
+	}
+
+	if !g.HasEdge("1,2", "1,3") || !g.HasEdge("1,2", "2,2") || g.HasEdge("1,3", "2,0") {
+		t.Error("unexpected grid edges")
+	}
+
+	directed, err := GenerateGrid(3, 4, gograph.Acyclic(), gograph.Weighted())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !directed.HasEdge("0,0", "0,1") || directed.HasEdge("0,1", "0,0") {
+		t.Error("expected directed edges to go right")
+	}
+
+	edge := directed.GetEdge(directed.GetVertexByID("0,0"), directed.GetVertexByID("1,0"))
+	if edge == nil || edge.Weight() != 1 {
+		t.Errorf("expected a unit weight edge, got %v", edge)
+	}
+}
+
+func TestGenerators(t *testing.T) {
+	tests := []struct {
+		name     string
+		generate func(n int, options ...gograph.GraphOptionFunc) (gograph.Graph[int], error)
+		edges    int
+	}{
+		{name: "complete", generate: GenerateComplete, edges: 15},
+		{name: "cycle", generate: GenerateCycle, edges: 6},
+		{name: "path", generate: GeneratePath, edges: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, options := range [][]gograph.GraphOptionFunc{nil, {gograph.Directed(), gograph.Weighted()}} {
+				g, err := tt.generate(6, options...)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if g.Order() != 6 {
+					t.Errorf("expected 6 vertices, got %d", g.Order())
+				}
+
+				if got := edgeCount(g); got != tt.edges {
+					t.Errorf("expected %d edges, got %d", tt.edges, got)
+				}
+			}
+
+			if _, err := tt.generate(-1); !errors.Is(err, ErrInvalidSize) {
+				t.Errorf("expected error %v, got %v", ErrInvalidSize, err)
+			}
+		})
+	}
+}
+
+func TestGenerateCycleErrors(t *testing.T) {
+	if _, err := GenerateCycle(2); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("expected error %v, got %v", ErrInvalidSize, err)
+	}
+
+	if _, err := GenerateCycle(4, gograph.Acyclic()); !errors.Is(err, gograph.ErrDAGCycle) {
+		t.Errorf("expected error %v, got %v", gograph.ErrDAGCycle, err)
+	}
+
+	if _, err := GenerateGrid(-1, 2); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("expected error %v, got %v", ErrInvalidSize, err)
+	}
+}
@@ -0,0 +1,136 @@
+package generate
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/gavinhailey/gograph"
+)
+
+var (
+	// ErrInvalidProbability is returned when a probability is not in the
+	// range of [0, 1].
+	ErrInvalidProbability = errors.New("probability must be in the range of [0, 1]")
+)
+
+// GenerateErdosRenyi generates a random graph with n vertices labeled from 0
+// to n-1, using the Erdős–Rényi G(n, p) model: each pair of vertices is
+// connected independently with probability p. The expected number of edges
+// is p*n*(n-1)/2.
+//
+// The same seeded rng produces the same graph, which makes it reproducible
+// for tests and benchmarks. If rng is nil, it uses a randomly seeded source.
+//
+// It accepts the graph options such as Directed and Weighted. In directed
+// graph, the edges go from the lower label to the higher one. In weighted
+// graph, all the edges have a unit weight.
+//
+// It returns ErrInvalidSize if n is negative, and ErrInvalidProbability if
+// p is not in the range of [0, 1] or NaN.
+func GenerateErdosRenyi(
+	n int,
+	p float64,
+	rng *rand.Rand,
+	options ...gograph.GraphOptionFunc,
+) (gograph.Graph[int], error) {
+	if p < 0 || p > 1 || math.IsNaN(p) {
+		return nil, ErrInvalidProbability
+	}
+
+	g, err := newIntGraph(n, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	rng = seededRand(rng)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if rng.Float64() >= p {
+				continue
+			}
+
+			if err = addEdge(g, i, j); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// GenerateBarabasiAlbert generates a random scale-free graph with n vertices
+// labeled from 0 to n-1, using the Barabási–Albert preferential attachment
+// model. It starts with a complete graph of m+1 vertices, and connects each
+// new vertex to m distinct existing vertices, which are chosen with the
+// probability proportional to their degrees. The degree distribution follows
+// a power law, and the graph has m*(m+1)/2 + (n-m-1)*m edges.
+//
+// The same seeded rng produces the same graph, which makes it reproducible
+// for tests and benchmarks. If rng is nil, it uses a randomly seeded source.
+//
+// It accepts the graph options such as Directed and Weighted. In directed
+// graph, the edges go from the higher label to the lower one, so the new
+// vertices point to the existing ones. In weighted graph, all the edges have
+// a unit weight.
+//
+// It returns ErrInvalidSize if m is less than 1 or n is not greater than m.
+func GenerateBarabasiAlbert(
+	n, m int,
+	rng *rand.Rand,
+	options ...gograph.GraphOptionFunc,
+) (gograph.Graph[int], error) {
+	if m < 1 || n <= m {
+		return nil, ErrInvalidSize
+	}
+
+	g, err := newIntGraph(n, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	// endpoints contains each vertex once per incident edge, so choosing a
+	// uniformly random element of it is proportional to the degrees.
+	endpoints := make([]int, 0, 2*m*n)
+	for j := 0; j <= m; j++ {
+		for i := 0; i < j; i++ {
+			if err = addEdge(g, j, i); err != nil {
+				return nil, err
+			}
+			endpoints = append(endpoints, i, j)
+		}
+	}
+
+	rng = seededRand(rng)
+	for v := m + 1; v < n; v++ {
+		targets := make(map[int]bool, m)
+		for len(targets) < m {
+			targets[endpoints[rng.Intn(len(endpoints))]] = true
+		}
+
+		// add the edges in ascending order of the targets, since the
+		// iteration order of the map is not deterministic.
+		for target := 0; target < v; target++ {
+			if !targets[target] {
+				continue
+			}
+
+			if err = addEdge(g, v, target); err != nil {
+				return nil, err
+			}
+			endpoints = append(endpoints, target, v)
+		}
+	}
+
+	return g, nil
+}
+
+// seededRand returns the specified rng, or a randomly seeded one if it is nil.
+func seededRand(rng *rand.Rand) *rand.Rand {
+	if rng != nil {
+		return rng
+	}
+
+	return rand.New(rand.NewSource(time.Now().UnixNano()))
+}
@@ -0,0 +1,81 @@
+package generate
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestGenerateErdosRenyi(t *testing.T) {
+	n, p := 200, 0.1
+
+	g, err := GenerateErdosRenyi(n, p, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := p * float64(n*(n-1)/2)
+	if got := float64(edgeCount(g)); math.Abs(got-expected) > 0.1*expected {
+		t.Errorf("expected about %v edges, got %v", expected, got)
+	}
+
+	// the same seed produces the same graph
+	again, _ := GenerateErdosRenyi(n, p, rand.New(rand.NewSource(1)))
+	h1, _ := gograph.Hash(g)
+	h2, _ := gograph.Hash(again)
+	if h1 != h2 {
+		t.Error("expected the same graph for the same seed")
+	}
+
+	for _, p := range []float64{0, 1} {
+		g, _ = GenerateErdosRenyi(10, p, rand.New(rand.NewSource(1)))
+		if got := edgeCount(g); got != int(p*45) {
+			t.Errorf("expected %d edges for p=%v, got %d", int(p*45), p, got)
+		}
+	}
+
+	for _, p := range []float64{-0.5, 1.5, math.NaN()} {
+		if _, err = GenerateErdosRenyi(10, p, nil); !errors.Is(err, ErrInvalidProbability) {
+			t.Errorf("expected error %v for p=%v, got %v", ErrInvalidProbability, p, err)
+		}
+	}
+}
+
+func TestGenerateBarabasiAlbert(t *testing.T) {
+	maxDegree := func(n int) int {
+		g, err := GenerateBarabasiAlbert(n, 2, rand.New(rand.NewSource(7)))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got, want := edgeCount(g), 3+(n-3)*2; got != want {
+			t.Errorf("expected %d edges, got %d", want, got)
+		}
+
+		return gograph.Stats(g).MaxDegree
+	}
+
+	// the hubs keep attracting the new vertices
+	small, large := maxDegree(100), maxDegree(2000)
+	if large <= small {
+		t.Errorf("expected the max degree to grow with n, got %d for 100 and %d for 2000", small, large)
+	}
+
+	directed, err := GenerateBarabasiAlbert(50, 3, rand.New(rand.NewSource(7)), gograph.Acyclic())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, v := range directed.GetAllVertices() {
+		if v.Label() > 3 && v.OutDegree() != 3 {
+			t.Errorf("expected vertex %d to have 3 outgoing edges, got %d", v.Label(), v.OutDegree())
+		}
+	}
+
+	if _, err = GenerateBarabasiAlbert(3, 3, nil); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("expected error %v, got %v", ErrInvalidSize, err)
+	}
+}
@@ -1,13 +1,24 @@
 package gograph
 
-import "errors"
+import (
+	"errors"
+	"sort"
+)
 
 var (
-	ErrNilVertices        = errors.New("vertices are nil")
-	ErrVertexDoesNotExist = errors.New("vertex does not exist")
-	ErrEdgeAlreadyExists  = errors.New("edge already exists")
-	ErrDAGCycle           = errors.New("edges would create cycle")
-	ErrDAGHasCycle        = errors.New("the graph contains a cycle")
+	ErrNilVertices         = errors.New("vertices are nil")
+	ErrVertexDoesNotExist  = errors.New("vertex does not exist")
+	ErrVertexAlreadyExists = errors.New("vertex already exists")
+	ErrEdgeAlreadyExists   = errors.New("edge already exists")
+	ErrDAGCycle            = errors.New("edges would create cycle")
+	ErrDAGHasCycle         = errors.New("the graph contains a cycle")
+	ErrNotDirected         = errors.New("graph is not directed")
+	ErrNotWeighted         = errors.New("graph is not weighted")
+	ErrNonFiniteWeight     = errors.New("edge weight is not finite")
+	ErrInvalidBinCount     = errors.New("number of bins must be positive")
+	ErrReadOnlyGraph       = errors.New("graph is read-only")
+	ErrGraphTypeMismatch   = errors.New("graphs have different types")
+	ErrSelfLoop            = errors.New("self-loops are not allowed")
 )
 
 // Graph defines methods for managing a graph with vertices and edges. It is the
@@ -37,6 +48,13 @@ type Graph[T comparable] interface {
 	// If edge already exist, returns error.
 	AddEdge(from, to *Vertex[T], options ...EdgeOptionFunc) (*Edge[T], error)
 
+	// GetOrAddEdge returns the edge from the 'from' vertex to the 'to'
+	// vertex if it already exists, and otherwise adds it like AddEdge. The
+	// boolean reports whether the edge was created. The options are only
+	// applied to a new edge, and the existing edge is left unchanged, so it
+	// is safe to call repeatedly to ensure that an edge exists.
+	GetOrAddEdge(from, to *Vertex[T], options ...EdgeOptionFunc) (*Edge[T], bool, error)
+
 	// GetAllEdges returns a slice of all edges connecting source vertex to
 	// target vertex if such vertices exist in this graph.
 	//
@@ -50,6 +68,14 @@ type Graph[T comparable] interface {
 	// AllEdges returns all the edges in the graph.
 	AllEdges() []*Edge[T]
 
+	// ForEachEdge calls the callback function on each edge of the graph,
+	// without allocating a slice like AllEdges. In undirected graph, it is
+	// called for both directions of each edge. If the callback function
+	// returns an error, the iteration is stopped and the error is returned.
+	//
+	// The callback function must not modify the graph.
+	ForEachEdge(f func(e *Edge[T]) error) error
+
 	// GetEdge returns an edge connecting source vertex to target vertex
 	// if such vertices and such edge exist in this graph.
 	//
@@ -60,6 +86,11 @@ type Graph[T comparable] interface {
 	// If edge does not exist, returns nil.
 	GetEdge(from, to *Vertex[T]) *Edge[T]
 
+	// GetEdgeByID returns the edge with the specified ID, and reports whether
+	// it exists. In undirected graph, returns the edge in the direction it
+	// was added.
+	GetEdgeByID(id uint64) (*Edge[T], bool)
+
 	// EdgesOf returns a slice of all edges touching the specified vertex.
 	// If no edges are touching the specified vertex returns an empty slice.
 	//
@@ -76,10 +107,18 @@ type Graph[T comparable] interface {
 	// Label of the vertex is a comparable type. This method also accepts the
 	// vertex properties such as weight.
 	//
-	// If there is a vertex with the same label in the graph, returns nil.
-	// Otherwise, returns the created vertex.
+	// If there is a vertex with the same label in the graph, the outcome
+	// depends on the DuplicateVertexPolicy set by WithDuplicateVertexPolicy:
+	// by default the existing vertex is returned unchanged. Otherwise,
+	// returns the created vertex.
 	AddVertexByLabel(label T, options ...VertexOptionFunc) *Vertex[T]
 
+	// TryAddVertex adds a new vertex like AddVertexByLabel, but reports the
+	// duplicates rejected by DuplicateVertexError: it returns an error
+	// wrapping ErrVertexAlreadyExists if there is a vertex with the same
+	// label in the graph under that policy.
+	TryAddVertex(label T, options ...VertexOptionFunc) (*Vertex[T], error)
+
 	// AddVertex adds the input vertex to the graph. It doesn't add
 	// vertex to the graph if the input vertex label is already exists
 	// in the graph.
@@ -98,10 +137,29 @@ type Graph[T comparable] interface {
 	// GetAllVertices returns a slice of all existing vertices in the graph.
 	GetAllVertices() []*Vertex[T]
 
+	// GetAllVerticesSorted returns a slice of all existing vertices in the
+	// graph, sorted by their labels with the specified less function. Unlike
+	// GetAllVertices, whose order is unspecified, the order is deterministic
+	// as long as the less function defines a total order of the labels.
+	GetAllVerticesSorted(less func(a, b T) bool) []*Vertex[T]
+
+	// ForEachVertex calls the callback function on each vertex of the graph,
+	// without allocating a slice like GetAllVertices. If the callback function
+	// returns an error, the iteration is stopped and the error is returned.
+	//
+	// The callback function must not modify the graph.
+	ForEachVertex(f func(v *Vertex[T]) error) error
+
 	// RemoveVertices removes all the specified vertices from this graph including
 	// all its touching edges if present.
 	RemoveVertices(vertices ...*Vertex[T])
 
+	// RemoveVerticesByLabel removes all the vertices with the specified labels
+	// from this graph including all their touching edges. The removal is atomic,
+	// if any of the labels doesn't exist, no vertex is removed and it returns
+	// ErrVertexDoesNotExist.
+	RemoveVerticesByLabel(labels ...T) error
+
 	// ContainsEdge returns 'true' if and only if this graph contains an edge
 	// going from the source vertex to the target vertex.
 	//
@@ -109,6 +167,16 @@ type Graph[T comparable] interface {
 	// returns 'false'.
 	ContainsEdge(from, to *Vertex[T]) bool
 
+	// HasEdge returns 'true' if there is an edge between the vertices with
+	// the specified labels.
+	//
+	// In directed graph, it only checks the edge going from the 'from' vertex
+	// to the 'to' vertex. In undirected graph, the order of the labels doesn't
+	// matter.
+	//
+	// If any of the specified vertices does not exist in the graph, returns 'false'.
+	HasEdge(from, to T) bool
+
 	// ContainsVertex returns 'true' if this graph contains the specified vertex.
 	//
 	// If the specified vertex is nil, returns 'false'.
@@ -128,9 +196,11 @@ func New[T comparable](options ...GraphOptionFunc) Graph[T] {
 
 // Edge represents an edges in a graph. It contains start and end points.
 type Edge[T comparable] struct {
+	id         uint64     // identifies the edge in its graph, 0 if it isn't added to a graph
 	source     *Vertex[T] // start point of the edges
 	dest       *Vertex[T] // destination or end point of the edges
 	properties EdgeProperties
+	origin     *Edge[T] // the edge of the underlying graph, for the edges of a view
 }
 
 func NewEdge[T comparable](source *Vertex[T], dest *Vertex[T], options ...EdgeOptionFunc) *Edge[T] {
@@ -146,11 +216,35 @@ func NewEdge[T comparable](source *Vertex[T], dest *Vertex[T], options ...EdgeOp
 	}
 }
 
+// ID returns the identifier of the edge, which is assigned by AddEdge from
+// a monotonic counter of the graph. It is unique in the graph and stable
+// until the edge is removed, regardless of the other changes of the graph,
+// so it can be used as a map key to track the edge. Both directions of an
+// undirected edge have the same ID. The copies of a graph assign their own
+// IDs, and the edges that aren't added to a graph have the ID 0.
+func (e *Edge[T]) ID() uint64 {
+	return e.id
+}
+
 // Weight returns the weight of the edge.
 func (e *Edge[T]) Weight() float64 {
+	if e.origin != nil {
+		return e.origin.Weight()
+	}
+
 	return e.properties.weight
 }
 
+// Cost returns the cost per unit of flow of the edge, which is set by
+// WithEdgeCapacityCost. The default cost is 0.
+func (e *Edge[T]) Cost() float64 {
+	if e.origin != nil {
+		return e.origin.Cost()
+	}
+
+	return e.properties.cost
+}
+
 // OtherVertex accepts the label of one the vertices of the edge
 // and returns the other one. If the input label doesn't match to
 // either of the vertices, returns nil.
@@ -182,10 +276,23 @@ type Vertex[T comparable] struct {
 	neighbors  []*Vertex[T] // stores pointers to its neighbors
 	inDegree   int          // number of incoming edges to this vertex
 	properties VertexProperties
+	handle     *viewHandle[T] // set on the vertices of a view, which compute their neighbors lazily
+}
+
+// viewHandle links a vertex of a view to the view and to the vertex of the
+// underlying graph.
+type viewHandle[T comparable] struct {
+	view   *filteredView[T]
+	origin *Vertex[T]
 }
 
 func NewVertex[T comparable](label T, options ...VertexOptionFunc) *Vertex[T] {
-	return &Vertex[T]{label: label}
+	var properties VertexProperties
+	for _, option := range options {
+		option(&properties)
+	}
+
+	return &Vertex[T]{label: label, properties: properties}
 }
 
 // NeighborByLabel iterates over the neighbor slice and returns the
@@ -193,9 +300,9 @@ func NewVertex[T comparable](label T, options ...VertexOptionFunc) *Vertex[T] {
 //
 // It returns nil if there is no neighbor with that label.
 func (v *Vertex[T]) NeighborByLabel(label T) *Vertex[T] {
-	for i := range v.neighbors {
-		if v.neighbors[i].label == label {
-			return v.neighbors[i]
+	for _, neighbor := range v.adjacent() {
+		if neighbor.label == label {
+			return neighbor
 		}
 	}
 
@@ -210,40 +317,128 @@ func (v *Vertex[T]) HasNeighbor(vertex *Vertex[T]) bool {
 }
 
 // InDegree returns the number of incoming edges to the current vertex.
+// The in-degree is maintained by the graph on each mutation, so reading
+// it is O(1). For the vertices of a FilteredView of a directed graph, it is
+// computed by scanning the graph.
 func (v *Vertex[T]) InDegree() int {
-	return v.inDegree
+	return v.incoming()
 }
 
 // OutDegree returns the number of outgoing edges to the current vertex.
+// It is the length of the neighbors slice, so reading it is O(1).
 func (v *Vertex[T]) OutDegree() int {
-	return len(v.neighbors)
+	return len(v.adjacent())
 }
 
 // Degree returns the total degree of the vertex which is the sum of
 // in and out degrees.
 func (v *Vertex[T]) Degree() int {
-	return v.inDegree + v.OutDegree()
+	return v.incoming() + v.OutDegree()
 }
 
 // Neighbors returns a copy of neighbor slice. If the caller changed the
 // result slice, it won't impact the graph or the vertex.
 func (v *Vertex[T]) Neighbors() []*Vertex[T] {
 	var neighbors []*Vertex[T]
-	for i := range v.neighbors {
+	for _, neighbor := range v.adjacent() {
 		clone := &Vertex[T]{}
-		*clone = *v.neighbors[i]
+		*clone = *neighbor
 		neighbors = append(neighbors, clone)
 	}
 
 	return neighbors
 }
 
+// NeighborsSorted returns a copy of neighbor slice, sorted by the specified
+// less function. Unlike Neighbors, which keeps the insertion order, it gives
+// a deterministic order that doesn't depend on how the edges were added.
+//
+// If the caller changed the result slice, it won't impact the graph or the vertex.
+func (v *Vertex[T]) NeighborsSorted(less func(a, b T) bool) []*Vertex[T] {
+	neighbors := v.Neighbors()
+	sort.SliceStable(neighbors, func(i, j int) bool {
+		return less(neighbors[i].label, neighbors[j].label)
+	})
+
+	return neighbors
+}
+
 // Label returns vertex label.
 func (v *Vertex[T]) Label() T {
 	return v.label
 }
 
-// Weight returns vertex label.
+// Weight returns the vertex weight, which is independent of the weights
+// of its edges. The default weight of a vertex is 0.
 func (v *Vertex[T]) Weight() float64 {
+	if v.handle != nil {
+		return v.handle.origin.Weight()
+	}
+
 	return v.properties.weight
 }
+
+// SetVertexWeight sets the vertex weight. The vertices of a read-only view
+// can't be changed, so it does nothing on them.
+func (v *Vertex[T]) SetVertexWeight(weight float64) {
+	if v.handle != nil {
+		return
+	}
+
+	v.properties.weight = weight
+}
+
+// adjacent returns the neighbors slice of the vertex, without copying it.
+// For the vertices of a view, the neighbors are computed by the view.
+func (v *Vertex[T]) adjacent() []*Vertex[T] {
+	if v.handle != nil {
+		return v.handle.view.neighborsOf(v.label)
+	}
+
+	return v.neighbors
+}
+
+// incoming returns the in-degree of the vertex. For the vertices of a view,
+// it is computed by the view.
+func (v *Vertex[T]) incoming() int {
+	if v.handle != nil {
+		return v.handle.view.inDegreeOf(v.label)
+	}
+
+	return v.inDegree
+}
+
+// inDegreesOf returns the in-degrees of the vertices. The in-degrees of the
+// vertices of a view are counted in one pass over their neighbors, instead
+// of scanning the graph for each vertex.
+func inDegreesOf[T comparable](vertices []*Vertex[T]) map[*Vertex[T]]int {
+	inDegrees := make(map[*Vertex[T]]int, len(vertices))
+	labels := make(map[T]*Vertex[T], len(vertices))
+	for _, v := range vertices {
+		if v.handle == nil {
+			inDegrees[v] = v.inDegree
+			continue
+		}
+
+		inDegrees[v] = 0
+		labels[v.label] = v
+	}
+
+	if len(labels) == 0 {
+		return inDegrees
+	}
+
+	for _, v := range vertices {
+		if v.handle == nil {
+			continue
+		}
+
+		for _, neighbor := range v.adjacent() {
+			if target, ok := labels[neighbor.label]; ok {
+				inDegrees[target]++
+			}
+		}
+	}
+
+	return inDegrees
+}
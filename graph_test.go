@@ -64,3 +64,125 @@ func TestEdge_OtherVertex(t *testing.T) {
 		t.Errorf("Expect OtherVertex return 1, but get %+v", edge.OtherVertex(2))
 	}
 }
+
+func TestVertex_NeighborsSorted(t *testing.T) {
+	g := New[string](Directed())
+	vA := g.AddVertexByLabel("A")
+	_, _ = g.AddEdge(vA, NewVertex("C"))
+	_, _ = g.AddEdge(vA, NewVertex("D"))
+	_, _ = g.AddEdge(vA, NewVertex("B"))
+
+	neighbors := vA.Neighbors()
+	insertionOrder := []string{"C", "D", "B"}
+	for i := range neighbors {
+		if neighbors[i].Label() != insertionOrder[i] {
+			t.Errorf(testErrMsgNotEqual, insertionOrder[i], neighbors[i].Label())
+		}
+	}
+
+	sorted := vA.NeighborsSorted(func(a, b string) bool {
+		return a < b
+	})
+
+	expected := []string{"B", "C", "D"}
+	if len(sorted) != len(expected) {
+		t.Fatalf(testErrMsgWrongLen, len(expected), len(sorted))
+	}
+
+	for i := range sorted {
+		if sorted[i].Label() != expected[i] {
+			t.Errorf(testErrMsgNotEqual, expected[i], sorted[i].Label())
+		}
+	}
+
+	// the default order must stay untouched
+	if vA.neighbors[0].Label() != "C" {
+		t.Errorf(testErrMsgNotEqual, "C", vA.neighbors[0].Label())
+	}
+}
+
+func TestVertexWeight(t *testing.T) {
+	g := New[string]()
+
+	v := g.AddVertexByLabel("A")
+	if v.Weight() != 0 {
+		t.Errorf(testErrMsgNotEqual, 0, v.Weight())
+	}
+
+	v.SetVertexWeight(2.5)
+	if g.GetVertexByID("A").Weight() != 2.5 {
+		t.Errorf(testErrMsgNotEqual, 2.5, g.GetVertexByID("A").Weight())
+	}
+
+	g.AddVertex(NewVertex("B", WithVertexWeight(3)))
+	if g.GetVertexByID("B").Weight() != 3 {
+		t.Errorf(testErrMsgNotEqual, 3, g.GetVertexByID("B").Weight())
+	}
+}
+
+func TestVertexDegreesAfterMutations(t *testing.T) {
+	for _, directed := range []bool{true, false} {
+		var options []GraphOptionFunc
+		if directed {
+			options = append(options, Directed())
+		}
+
+		g := New[int](options...)
+		degrees := func(label int) [2]int {
+			v := g.GetVertexByID(label)
+			return [2]int{v.InDegree(), v.OutDegree()}
+		}
+
+		check := func(step string, label int, directedDegrees, undirectedDegrees [2]int) {
+			t.Helper()
+
+			expected := undirectedDegrees
+			if directed {
+				expected = directedDegrees
+			}
+
+			if got := degrees(label); got != expected {
+				t.Errorf("directed=%v, %s: expected degrees of %d to be %v, got %v",
+					directed, step, label, expected, got)
+			}
+		}
+
+		_, _ = g.AddEdge(NewVertex(1), NewVertex(2))
+		_, _ = g.AddEdge(NewVertex(1), NewVertex(3))
+		_, _ = g.AddEdge(NewVertex(3), NewVertex(2))
+		check("add", 1, [2]int{0, 2}, [2]int{2, 2})
+		check("add", 2, [2]int{2, 0}, [2]int{2, 2})
+
+		g.RemoveEdges(g.GetEdge(g.GetVertexByID(1), g.GetVertexByID(2)))
+		check("remove edge", 1, [2]int{0, 1}, [2]int{1, 1})
+		check("remove edge", 2, [2]int{1, 0}, [2]int{1, 1})
+
+		_, _ = g.AddEdge(g.GetVertexByID(2), g.GetVertexByID(1))
+		check("add again", 1, [2]int{1, 1}, [2]int{2, 2})
+
+		g.RemoveVertices(g.GetVertexByID(3))
+		check("remove vertex", 1, [2]int{1, 0}, [2]int{1, 1})
+		check("remove vertex", 2, [2]int{0, 1}, [2]int{1, 1})
+
+		_ = g.RemoveVerticesByLabel(2)
+		check("remove vertex by label", 1, [2]int{0, 0}, [2]int{0, 0})
+	}
+}
+
+func BenchmarkVertexDegree(b *testing.B) {
+	g := New[int](Directed())
+	for i := 1; i < 1000; i++ {
+		_, _ = g.AddEdge(NewVertex(0), NewVertex(i))
+		_, _ = g.AddEdge(NewVertex(i), NewVertex((i%999)+1))
+	}
+	vertices := g.GetAllVertices()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var total int
+		for _, v := range vertices {
+			total += v.InDegree() + v.OutDegree()
+		}
+		_ = total
+	}
+}
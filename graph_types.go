@@ -3,6 +3,10 @@ package gograph
 // GraphType defines methods to determine the type of graph.
 // A graph can have multiple types. e.g., a directed graph
 // can be a weighted or acyclic.
+//
+// The implementations of the Graph interface outside this package must
+// implement all of its methods, including IsMulti and AllowsSelfLoops. The
+// types that embed a Graph get them from the embedded graph.
 type GraphType interface {
 	// IsDirected returns true if the graph is directed, false otherwise.
 	IsDirected() bool
@@ -12,6 +16,17 @@ type GraphType interface {
 
 	// IsWeighted returns true if the graph is weighted, false otherwise.
 	IsWeighted() bool
+
+	// IsMulti returns true if the graph can have multiple edges between
+	// the same pair of vertices, false otherwise. The graphs created by New
+	// are never multigraphs, so it is false for them, and it only reports
+	// true for the other implementations of the Graph interface.
+	IsMulti() bool
+
+	// AllowsSelfLoops returns true if the graph can have edges from a
+	// vertex to itself, false otherwise. The graphs created by New reject
+	// the self loops if they are acyclic or created with WithoutSelfLoops.
+	AllowsSelfLoops() bool
 }
 
 // IsDirected returns true if the graph is directed, false otherwise.
@@ -28,3 +43,15 @@ func (g *baseGraph[T]) IsAcyclic() bool {
 func (g *baseGraph[T]) IsWeighted() bool {
 	return g.properties.isWeighted
 }
+
+// IsMulti returns false, since AddEdge rejects parallel edges with
+// ErrEdgeAlreadyExists.
+func (g *baseGraph[T]) IsMulti() bool {
+	return false
+}
+
+// AllowsSelfLoops returns true, unless the graph is acyclic, since a self
+// loop is a cycle, or it is created with WithoutSelfLoops.
+func (g *baseGraph[T]) AllowsSelfLoops() bool {
+	return !g.properties.isAcyclic && !g.properties.noSelfLoops
+}
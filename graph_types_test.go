@@ -0,0 +1,88 @@
+package gograph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGraphType(t *testing.T) {
+	tests := []struct {
+		name                                                string
+		options                                             []GraphOptionFunc
+		directed, acyclic, weighted, multi, allowsSelfLoops bool
+	}{
+		{name: "default", allowsSelfLoops: true},
+		{name: "directed", options: []GraphOptionFunc{Directed()}, directed: true, allowsSelfLoops: true},
+		{name: "acyclic", options: []GraphOptionFunc{Acyclic()}, directed: true, acyclic: true},
+		{name: "weighted", options: []GraphOptionFunc{Weighted()}, weighted: true, allowsSelfLoops: true},
+		{name: "without self loops", options: []GraphOptionFunc{WithoutSelfLoops()}},
+		{name: "directed without self loops", options: []GraphOptionFunc{Directed(), WithoutSelfLoops()}, directed: true},
+		{
+			name:            "label equality",
+			options:         []GraphOptionFunc{WithLabelEquality(func(a, b int) bool { return a == b }, func(a int) uint64 { return uint64(a) })},
+			allowsSelfLoops: true,
+		},
+		{
+			name:            "duplicate vertex policy",
+			options:         []GraphOptionFunc{WithDuplicateVertexPolicy(DuplicateVertexReject)},
+			allowsSelfLoops: true,
+		},
+		{
+			name:     "all",
+			options:  []GraphOptionFunc{Acyclic(), Weighted()},
+			directed: true, acyclic: true, weighted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := New[int](tt.options...)
+			views := map[string]Graph[int]{
+				"graph":    g,
+				"view":     FilteredView[int](g, nil, nil),
+				"snapshot": Snapshot(g),
+			}
+
+			for name, view := range views {
+				got := []bool{view.IsDirected(), view.IsAcyclic(), view.IsWeighted(), view.IsMulti(), view.AllowsSelfLoops()}
+				expected := []bool{tt.directed, tt.acyclic, tt.weighted, tt.multi, tt.allowsSelfLoops}
+				for i := range got {
+					if got[i] != expected[i] {
+						t.Errorf("%s: "+testErrMsgNotEqual, name, expected, got)
+						break
+					}
+				}
+			}
+
+			// the accessors match what AddEdge accepts
+			if _, err := g.AddEdge(NewVertex(1), NewVertex(1)); (err == nil) != tt.allowsSelfLoops {
+				t.Errorf("self loop: expected allowed %v, got error %v", tt.allowsSelfLoops, err)
+			}
+
+			_, _ = g.AddEdge(NewVertex(1), NewVertex(2))
+			if _, err := g.AddEdge(NewVertex(1), NewVertex(2)); (err == nil) != tt.multi {
+				t.Errorf("parallel edge: expected allowed %v, got error %v", tt.multi, err)
+			}
+		})
+	}
+}
+
+func TestWithoutSelfLoops(t *testing.T) {
+	g := New[int](WithoutSelfLoops())
+	if _, err := g.AddEdge(NewVertex(1), NewVertex(1)); !errors.Is(err, ErrSelfLoop) {
+		t.Errorf(testErrMsgNotEqual, ErrSelfLoop, err)
+	}
+
+	if g.Size() != 0 || g.GetVertexByID(1).Degree() != 0 {
+		t.Error("expected the self loop to be rejected")
+	}
+
+	// the other edges and the clones are not affected
+	if _, err := g.AddEdge(NewVertex(1), NewVertex(2)); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if Clone(g).AllowsSelfLoops() {
+		t.Error(testErrMsgNotFalse)
+	}
+}
@@ -0,0 +1,133 @@
+package gograph
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+	"sort"
+)
+
+// Hash returns a structure-sensitive hash of the graph, which is useful as
+// a cache key for expensive computations. It uses the Weisfeiler-Lehman
+// refinement: each vertex starts with a color derived from its label and
+// weight, and in each round, its color is recomputed from its own color and
+// the sorted colors of its neighbors along with the edge weights. The hash
+// is derived from the sorted multiset of the final colors.
+//
+// Equal graphs, and isomorphic graphs with the same labels, always have the
+// same hash, while structurally different graphs have different hashes with
+// high probability. The labels are hashed by their fmt representation, so
+// the hash is stable across runs as long as the representation is stable,
+// e.g., labels must not be pointers.
+//
+// It returns error if it fails to hash a label.
+func Hash[T comparable](g Graph[T]) (uint64, error) {
+	vertices := g.GetAllVertices()
+
+	// Build the reverse adjacency, the incoming edges of a vertex in
+	// directed graph are part of its structure too.
+	predecessors := make(map[T][]*Edge[T])
+	if g.IsDirected() {
+		for _, edge := range g.AllEdges() {
+			predecessors[edge.dest.label] = append(predecessors[edge.dest.label], edge)
+		}
+	}
+
+	colors := make(map[T]uint64, len(vertices))
+	for _, v := range vertices {
+		h := fnv.New64a()
+		if _, err := fmt.Fprint(h, v.label); err != nil {
+			return 0, err
+		}
+		writeUint64(h, math.Float64bits(v.Weight()))
+		colors[v.label] = h.Sum64()
+	}
+
+	// The refinement is stable after at most |V| rounds, stop earlier if the
+	// number of distinct colors stops increasing.
+	distinct := countDistinct(colors)
+	for round := 0; round < len(vertices); round++ {
+		next := make(map[T]uint64, len(vertices))
+		for _, v := range vertices {
+			neighbors := v.adjacent()
+			out := make([]uint64, 0, len(neighbors))
+			for _, neighbor := range neighbors {
+				out = append(out, hashEdge(colors[neighbor.label], g.GetEdge(v, neighbor)))
+			}
+
+			in := make([]uint64, 0, len(predecessors[v.label]))
+			for _, edge := range predecessors[v.label] {
+				in = append(in, hashEdge(colors[edge.source.label], edge))
+			}
+
+			next[v.label] = hashColors(colors[v.label], out, in)
+		}
+		colors = next
+
+		n := countDistinct(colors)
+		if n == distinct && round > 0 {
+			break
+		}
+		distinct = n
+	}
+
+	final := make([]uint64, 0, len(colors))
+	for _, color := range colors {
+		final = append(final, color)
+	}
+
+	var flags uint64
+	if g.IsDirected() {
+		flags |= 1
+	}
+	if g.IsWeighted() {
+		flags |= 2
+	}
+
+	return hashColors(flags, final, nil), nil
+}
+
+// hashEdge combines the color of the other end of the edge with the edge weight.
+func hashEdge[T comparable](color uint64, edge *Edge[T]) uint64 {
+	h := fnv.New64a()
+	writeUint64(h, color)
+	if edge != nil {
+		writeUint64(h, math.Float64bits(edge.Weight()))
+	}
+	return h.Sum64()
+}
+
+// hashColors hashes the specified color along with the sorted multisets of
+// outgoing and incoming colors. It sorts the input slices in place.
+func hashColors(color uint64, out, in []uint64) uint64 {
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	sort.Slice(in, func(i, j int) bool { return in[i] < in[j] })
+
+	h := fnv.New64a()
+	writeUint64(h, color)
+	writeUint64(h, uint64(len(out)))
+	for _, c := range out {
+		writeUint64(h, c)
+	}
+	writeUint64(h, uint64(len(in)))
+	for _, c := range in {
+		writeUint64(h, c)
+	}
+	return h.Sum64()
+}
+
+func writeUint64(h hash.Hash, n uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], n)
+	_, _ = h.Write(buf[:])
+}
+
+func countDistinct[T comparable](colors map[T]uint64) int {
+	seen := make(map[uint64]bool, len(colors))
+	for _, c := range colors {
+		seen[c] = true
+	}
+	return len(seen)
+}
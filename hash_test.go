@@ -0,0 +1,83 @@
+package gograph
+
+import "testing"
+
+func TestHash(t *testing.T) {
+	build := func(edges [][2]string) Graph[string] {
+		g := New[string](Directed())
+		for _, e := range edges {
+			_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+		}
+		return g
+	}
+
+	edges := [][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"A", "D"}}
+	g1 := build(edges)
+
+	// the same graph built in a different insertion order
+	g2 := build([][2]string{edges[3], edges[1], edges[0], edges[2]})
+
+	h1, err := Hash(g1)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	h2, err := Hash(g2)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if h1 != h2 {
+		t.Errorf(testErrMsgNotEqual, h1, h2)
+	}
+
+	// hashing again must be stable
+	again, _ := Hash(g1)
+	if again != h1 {
+		t.Errorf(testErrMsgNotEqual, h1, again)
+	}
+
+	tests := []struct {
+		name  string
+		graph Graph[string]
+	}{
+		{name: "extra edge", graph: build(append([][2]string{{"B", "D"}}, edges...))},
+		{name: "reversed edge", graph: build([][2]string{{"A", "B"}, {"B", "C"}, {"D", "C"}, {"A", "D"}})},
+		{name: "moved edge", graph: build([][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"A", "C"}})},
+		{name: "undirected", graph: func() Graph[string] {
+			g := New[string]()
+			for _, e := range edges {
+				_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+			}
+			return g
+		}()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := Hash(tt.graph)
+			if err != nil {
+				t.Fatalf(testErrMsgError, err)
+			}
+
+			if h == h1 {
+				t.Errorf("expected different hashes, but got %d for both", h)
+			}
+		})
+	}
+}
+
+func TestHashEdgeWeight(t *testing.T) {
+	build := func(weight float64) Graph[int] {
+		g := New[int](Weighted())
+		_, _ = g.AddEdge(NewVertex(1), NewVertex(2), WithEdgeWeight(weight))
+		_, _ = g.AddEdge(NewVertex(2), NewVertex(3), WithEdgeWeight(1))
+		return g
+	}
+
+	h1, _ := Hash(build(1))
+	h2, _ := Hash(build(2))
+	if h1 == h2 {
+		t.Errorf("expected different hashes, but got %d for both", h1)
+	}
+}
@@ -0,0 +1,88 @@
+package gograph
+
+import "math"
+
+// HistogramBin is a range of edge weights and the number of edges whose
+// weight falls into it.
+type HistogramBin struct {
+	// Min is the inclusive lower bound of the range.
+	Min float64
+
+	// Max is the upper bound of the range. It is exclusive, except for the
+	// last bin, which includes the maximum weight.
+	Max float64
+
+	// Count is the number of edges in the range.
+	Count int
+}
+
+// WeightHistogram buckets the edge weights of the graph into the specified
+// number of equal-width ranges between the minimum and the maximum weight,
+// and counts the edges of each range, e.g., to inspect the distribution of
+// the weights before NormalizeWeights. In undirected graph, each edge is
+// counted once, although it is stored in both directions.
+//
+// If all the weights are equal, the ranges would have no width, so it
+// returns a single bin of that weight with all the edges. A graph without
+// edges has no bins.
+//
+// It returns ErrNotWeighted if the graph is not weighted, ErrInvalidBinCount
+// if the number of bins is not positive, and ErrNonFiniteWeight if any weight
+// is infinite or NaN.
+func WeightHistogram[T comparable](g Graph[T], bins int) ([]HistogramBin, error) {
+	if !g.IsWeighted() {
+		return nil, ErrNotWeighted
+	}
+
+	if bins <= 0 {
+		return nil, ErrInvalidBinCount
+	}
+
+	// both directions of an undirected edge have the same ID
+	var weights []float64
+	seen := make(map[uint64]bool)
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, edge := range g.AllEdges() {
+		if seen[edge.id] {
+			continue
+		}
+		seen[edge.id] = true
+
+		w := edge.Weight()
+		if math.IsInf(w, 0) || math.IsNaN(w) {
+			return nil, ErrNonFiniteWeight
+		}
+
+		weights = append(weights, w)
+		low = math.Min(low, w)
+		high = math.Max(high, w)
+	}
+
+	if len(weights) == 0 {
+		return nil, nil
+	}
+
+	if high == low {
+		return []HistogramBin{{Min: low, Max: high, Count: len(weights)}}, nil
+	}
+
+	// the range of finite weights may not be finite, e.g., between
+	// -math.MaxFloat64 and math.MaxFloat64, so the widths are computed on
+	// the halves of the weights, which never overflow.
+	halfWidth := (high/2 - low/2) / float64(bins)
+	histogram := make([]HistogramBin, bins)
+	for i := range histogram {
+		histogram[i].Min = low + float64(i)*halfWidth + float64(i)*halfWidth
+		histogram[i].Max = low + float64(i+1)*halfWidth + float64(i+1)*halfWidth
+	}
+	histogram[bins-1].Max = high
+
+	for _, w := range weights {
+		// the maximum weight belongs to the last bin, and the rounding
+		// errors must not move a weight out of the bins
+		i := int((w/2 - low/2) / halfWidth)
+		histogram[max(0, min(i, bins-1))].Count++
+	}
+
+	return histogram, nil
+}
@@ -0,0 +1,120 @@
+package gograph
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestWeightHistogram(t *testing.T) {
+	// a directed path with the weights 1 to 10
+	g := New[int](Directed(), Weighted())
+	for i := 1; i <= 10; i++ {
+		_, _ = g.AddEdge(NewVertex(i-1), NewVertex(i), WithEdgeWeight(float64(i)))
+	}
+
+	histogram, err := WeightHistogram(g, 3)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	// the last bin includes the maximum weight
+	expected := []HistogramBin{
+		{Min: 1, Max: 4, Count: 3},
+		{Min: 4, Max: 7, Count: 3},
+		{Min: 7, Max: 10, Count: 4},
+	}
+	if !reflect.DeepEqual(histogram, expected) {
+		t.Errorf(testErrMsgNotEqual, expected, histogram)
+	}
+}
+
+func TestWeightHistogramUndirected(t *testing.T) {
+	g := New[string](Weighted())
+	_, _ = g.AddEdge(NewVertex("A"), NewVertex("B"), WithEdgeWeight(1))
+	_, _ = g.AddEdge(NewVertex("B"), NewVertex("C"), WithEdgeWeight(1))
+	_, _ = g.AddEdge(NewVertex("C"), NewVertex("D"), WithEdgeWeight(2))
+	_, _ = g.AddEdge(NewVertex("D"), NewVertex("A"), WithEdgeWeight(5))
+
+	histogram, err := WeightHistogram(g, 2)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	// each edge is counted once
+	expected := []HistogramBin{
+		{Min: 1, Max: 3, Count: 3},
+		{Min: 3, Max: 5, Count: 1},
+	}
+	if !reflect.DeepEqual(histogram, expected) {
+		t.Errorf(testErrMsgNotEqual, expected, histogram)
+	}
+}
+
+func TestWeightHistogramEqualWeights(t *testing.T) {
+	g := New[int](Directed(), Weighted())
+	for i := 1; i <= 4; i++ {
+		_, _ = g.AddEdge(NewVertex(0), NewVertex(i), WithEdgeWeight(2.5))
+	}
+
+	histogram, err := WeightHistogram(g, 5)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	expected := []HistogramBin{{Min: 2.5, Max: 2.5, Count: 4}}
+	if !reflect.DeepEqual(histogram, expected) {
+		t.Errorf(testErrMsgNotEqual, expected, histogram)
+	}
+
+	// a graph without edges has no bins
+	histogram, err = WeightHistogram(New[int](Weighted()), 5)
+	if err != nil || len(histogram) != 0 {
+		t.Errorf(testErrMsgNotEqual, []HistogramBin{}, histogram)
+	}
+}
+
+func TestWeightHistogramErrors(t *testing.T) {
+	if _, err := WeightHistogram(New[int](), 2); !errors.Is(err, ErrNotWeighted) {
+		t.Errorf(testErrMsgNotEqual, ErrNotWeighted, err)
+	}
+
+	g := New[int](Weighted())
+	if _, err := WeightHistogram(g, 0); !errors.Is(err, ErrInvalidBinCount) {
+		t.Errorf(testErrMsgNotEqual, ErrInvalidBinCount, err)
+	}
+
+	_, _ = g.AddEdge(NewVertex(1), NewVertex(2), WithEdgeWeight(math.Inf(1)))
+	if _, err := WeightHistogram(g, 2); !errors.Is(err, ErrNonFiniteWeight) {
+		t.Errorf(testErrMsgNotEqual, ErrNonFiniteWeight, err)
+	}
+}
+
+func TestWeightHistogramExtremeWeights(t *testing.T) {
+	g := New[int](Directed(), Weighted())
+	_, _ = g.AddEdge(NewVertex(1), NewVertex(2), WithEdgeWeight(-math.MaxFloat64))
+	_, _ = g.AddEdge(NewVertex(2), NewVertex(3), WithEdgeWeight(0))
+	_, _ = g.AddEdge(NewVertex(3), NewVertex(4), WithEdgeWeight(math.MaxFloat64))
+
+	histogram, err := WeightHistogram(g, 4)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	counts := make([]int, len(histogram))
+	for i, bin := range histogram {
+		counts[i] = bin.Count
+		if math.IsInf(bin.Min, 0) || math.IsInf(bin.Max, 0) {
+			t.Errorf("Expected finite bounds, got [%v, %v]", bin.Min, bin.Max)
+		}
+	}
+
+	if expected := []int{1, 0, 1, 1}; !reflect.DeepEqual(counts, expected) {
+		t.Errorf(testErrMsgNotEqual, expected, counts)
+	}
+
+	if histogram[0].Min != -math.MaxFloat64 || histogram[3].Max != math.MaxFloat64 {
+		t.Errorf(testErrMsgNotEqual, []float64{-math.MaxFloat64, math.MaxFloat64}, []float64{histogram[0].Min, histogram[3].Max})
+	}
+}
@@ -0,0 +1,193 @@
+package hypergraph
+
+import (
+	"errors"
+
+	"github.com/gavinhailey/gograph"
+)
+
+var (
+	ErrUnknownExpansionMode = errors.New("unknown expansion mode")
+
+	// ErrNoHyperedgeLabel is returned by the star expansion if the
+	// hypergraph has no hyperedge labels set by WithHyperedgeLabel.
+	ErrNoHyperedgeLabel = errors.New("star expansion requires hyperedge labels")
+
+	// ErrLabelCollision is returned by the star expansion if the label of a
+	// hyperedge is the same as the label of a vertex or another hyperedge.
+	ErrLabelCollision = errors.New("hyperedge label collides with another label")
+)
+
+// ExpansionMode decides how ToGraph projects the hyperedges to pairwise
+// edges.
+type ExpansionMode int
+
+const (
+	// CliqueExpansion connects every pair of vertices of each hyperedge.
+	// The weight of an edge is the number of hyperedges that contain both
+	// of its vertices.
+	CliqueExpansion ExpansionMode = iota
+
+	// StarExpansion adds a vertex for each hyperedge, and connects it to
+	// the vertices of the hyperedge, which makes a bipartite graph. Unlike
+	// the clique expansion, it keeps the hyperedges apart.
+	StarExpansion
+)
+
+// OptionFunc represent an alias of function type that modifies the
+// specified hypergraph.
+type OptionFunc[T comparable] func(h *Hypergraph[T])
+
+// WithHyperedgeLabel sets the function that labels the vertex of each
+// hyperedge in the star expansion, by the index of the hyperedge in the
+// order they were added.
+func WithHyperedgeLabel[T comparable](label func(index int) T) OptionFunc[T] {
+	return func(h *Hypergraph[T]) {
+		h.hyperedgeLabel = label
+	}
+}
+
+// Hypergraph stores hyperedges, which connect any number of vertices, unlike
+// the pairwise edges of gograph.Graph. It is an adapter: ToGraph projects it
+// to a gograph.Graph, so the existing algorithms can run on it.
+type Hypergraph[T comparable] struct {
+	vertices       []T
+	contains       map[T]bool
+	hyperedges     [][]T
+	hyperedgeLabel func(index int) T
+}
+
+// New creates an empty hypergraph with the specified options.
+func New[T comparable](options ...OptionFunc[T]) *Hypergraph[T] {
+	h := &Hypergraph[T]{contains: make(map[T]bool)}
+	for _, option := range options {
+		option(h)
+	}
+
+	return h
+}
+
+// AddVertex adds a vertex with the specified label, that doesn't have to be
+// part of any hyperedge. It does nothing if the vertex already exists.
+func (h *Hypergraph[T]) AddVertex(label T) {
+	if !h.contains[label] {
+		h.contains[label] = true
+		h.vertices = append(h.vertices, label)
+	}
+}
+
+// AddHyperedge adds a hyperedge that connects the vertices with the
+// specified labels, and creates the vertices if they don't exist. The
+// repeated labels are counted once, and a hyperedge without labels is
+// ignored. Unlike gograph.Graph.AddEdge, the same set of vertices can be
+// connected by multiple hyperedges.
+func (h *Hypergraph[T]) AddHyperedge(labels ...T) {
+	seen := make(map[T]bool, len(labels))
+	var hyperedge []T
+	for _, label := range labels {
+		if !seen[label] {
+			seen[label] = true
+			hyperedge = append(hyperedge, label)
+			h.AddVertex(label)
+		}
+	}
+
+	if len(hyperedge) > 0 {
+		h.hyperedges = append(h.hyperedges, hyperedge)
+	}
+}
+
+// Vertices returns the labels of the vertices in the order they were added.
+func (h *Hypergraph[T]) Vertices() []T {
+	return append([]T(nil), h.vertices...)
+}
+
+// Hyperedges returns the labels of the vertices of each hyperedge, in the
+// order they were added. The returned slices are copies.
+func (h *Hypergraph[T]) Hyperedges() [][]T {
+	hyperedges := make([][]T, len(h.hyperedges))
+	for i, hyperedge := range h.hyperedges {
+		hyperedges[i] = append([]T(nil), hyperedge...)
+	}
+
+	return hyperedges
+}
+
+// ToGraph projects the hypergraph to a new weighted undirected graph with
+// the specified expansion mode. The graph contains all the vertices of the
+// hypergraph, including the ones that aren't part of any hyperedge.
+//
+// It returns ErrUnknownExpansionMode if the mode is unknown. The star
+// expansion returns ErrNoHyperedgeLabel if the hypergraph has no hyperedge
+// labels, and ErrLabelCollision if a hyperedge label is already used.
+func (h *Hypergraph[T]) ToGraph(mode ExpansionMode) (gograph.Graph[T], error) {
+	g := gograph.New[T](gograph.Weighted())
+	for _, label := range h.vertices {
+		g.AddVertexByLabel(label)
+	}
+
+	switch mode {
+	case CliqueExpansion:
+		h.expandCliques(g)
+	case StarExpansion:
+		if err := h.expandStars(g); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrUnknownExpansionMode
+	}
+
+	return g, nil
+}
+
+// expandCliques connects every pair of vertices of each hyperedge, counting
+// the shared hyperedges of each pair in the edge weight.
+func (h *Hypergraph[T]) expandCliques(g gograph.Graph[T]) {
+	type pair struct{ a, b T }
+
+	// the pairs are added in the order they first appear
+	var pairs []pair
+	weights := make(map[pair]float64)
+	for _, hyperedge := range h.hyperedges {
+		for i, a := range hyperedge {
+			for _, b := range hyperedge[i+1:] {
+				p := pair{a, b}
+				if _, ok := weights[pair{b, a}]; ok {
+					p = pair{b, a}
+				}
+
+				if _, ok := weights[p]; !ok {
+					pairs = append(pairs, p)
+				}
+				weights[p]++
+			}
+		}
+	}
+
+	for _, p := range pairs {
+		_, _ = g.AddEdge(g.GetVertexByID(p.a), g.GetVertexByID(p.b), gograph.WithEdgeWeight(weights[p]))
+	}
+}
+
+// expandStars adds a vertex for each hyperedge, connected to the vertices of
+// the hyperedge by unit weight edges.
+func (h *Hypergraph[T]) expandStars(g gograph.Graph[T]) error {
+	if h.hyperedgeLabel == nil && len(h.hyperedges) > 0 {
+		return ErrNoHyperedgeLabel
+	}
+
+	for i, hyperedge := range h.hyperedges {
+		label := h.hyperedgeLabel(i)
+		if g.GetVertexByID(label) != nil {
+			return ErrLabelCollision
+		}
+
+		center := g.AddVertexByLabel(label)
+
+		for _, member := range hyperedge {
+			_, _ = g.AddEdge(center, g.GetVertexByID(member), gograph.WithEdgeWeight(1))
+		}
+	}
+
+	return nil
+}
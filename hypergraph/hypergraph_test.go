@@ -0,0 +1,120 @@
+package hypergraph
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestHypergraph(t *testing.T) {
+	h := New[string]()
+	h.AddHyperedge("A", "B", "C", "A")
+	h.AddHyperedge("B", "C")
+	h.AddHyperedge()
+	h.AddVertex("D")
+
+	if got := h.Vertices(); !reflect.DeepEqual(got, []string{"A", "B", "C", "D"}) {
+		t.Errorf("Expected vertices %v, got %v", []string{"A", "B", "C", "D"}, got)
+	}
+
+	expected := [][]string{{"A", "B", "C"}, {"B", "C"}}
+	hyperedges := h.Hyperedges()
+	if !reflect.DeepEqual(hyperedges, expected) {
+		t.Errorf("Expected hyperedges %v, got %v", expected, hyperedges)
+	}
+
+	// the returned hyperedges are copies
+	hyperedges[0][0] = "X"
+	if h.Hyperedges()[0][0] != "A" {
+		t.Error("Expected the hyperedges to be unchanged")
+	}
+}
+
+func TestToGraph_CliqueExpansion(t *testing.T) {
+	h := New[string]()
+	h.AddHyperedge("A", "B", "C")
+	h.AddHyperedge("C", "B", "D")
+	h.AddVertex("E")
+
+	g, err := h.ToGraph(CliqueExpansion)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if g.IsDirected() || !g.IsWeighted() {
+		t.Error("Expected an undirected weighted graph")
+	}
+
+	// B-C is shared by both hyperedges
+	weights := map[[2]string]float64{
+		{"A", "B"}: 1, {"A", "C"}: 1, {"B", "C"}: 2,
+		{"B", "D"}: 1, {"C", "D"}: 1,
+	}
+
+	if g.Order() != 5 || g.Size() != uint32(2*len(weights)) {
+		t.Errorf("Expected 5 vertices and %d edges, got %d and %d", 2*len(weights), g.Order(), g.Size())
+	}
+
+	for pair, weight := range weights {
+		edge := g.GetEdge(g.GetVertexByID(pair[0]), g.GetVertexByID(pair[1]))
+		if edge == nil {
+			t.Errorf("Expected edge %v", pair)
+			continue
+		}
+
+		if edge.Weight() != weight {
+			t.Errorf("Expected weight %v of edge %v, got %v", weight, pair, edge.Weight())
+		}
+	}
+
+	if g.HasEdge("A", "D") {
+		t.Error("Expected no edge between A and D")
+	}
+}
+
+func TestToGraph_StarExpansion(t *testing.T) {
+	h := New(WithHyperedgeLabel(func(index int) string {
+		return fmt.Sprintf("e%d", index)
+	}))
+	h.AddHyperedge("A", "B", "C")
+	h.AddHyperedge("B", "C")
+
+	g, err := h.ToGraph(StarExpansion)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if g.Order() != 5 || g.Size() != 2*5 {
+		t.Errorf("Expected 5 vertices and 10 edges, got %d and %d", g.Order(), g.Size())
+	}
+
+	for _, pair := range [][2]string{{"e0", "A"}, {"e0", "B"}, {"e0", "C"}, {"e1", "B"}, {"e1", "C"}} {
+		if !g.HasEdge(pair[0], pair[1]) {
+			t.Errorf("Expected edge %v", pair)
+		}
+	}
+
+	if g.HasEdge("B", "C") {
+		t.Error("Expected no edge between the vertices of a hyperedge")
+	}
+}
+
+func TestToGraph_Errors(t *testing.T) {
+	h := New[string]()
+	h.AddHyperedge("A", "B")
+
+	if _, err := h.ToGraph(StarExpansion); !errors.Is(err, ErrNoHyperedgeLabel) {
+		t.Errorf("Expected error %v, got %v", ErrNoHyperedgeLabel, err)
+	}
+
+	if _, err := h.ToGraph(ExpansionMode(-1)); !errors.Is(err, ErrUnknownExpansionMode) {
+		t.Errorf("Expected error %v, got %v", ErrUnknownExpansionMode, err)
+	}
+
+	colliding := New(WithHyperedgeLabel(func(int) string { return "A" }))
+	colliding.AddHyperedge("A", "B")
+	if _, err := colliding.ToGraph(StarExpansion); !errors.Is(err, ErrLabelCollision) {
+		t.Errorf("Expected error %v, got %v", ErrLabelCollision, err)
+	}
+}
@@ -0,0 +1,188 @@
+package gograph
+
+import "sort"
+
+// IncrementalDAG maintains a directed acyclic graph along with a topological
+// order of its vertices while edges are being added. It uses the dynamic
+// topological sort algorithm of Pearce and Kelly, so when a new edge is
+// consistent with the current order, it is added in constant time, otherwise
+// only the vertices between the endpoints of the edge in the current order
+// are visited and reordered, instead of scanning the whole graph.
+//
+// It is useful for online DAG maintenance, where the naive approach of the
+// Acyclic graph option sorts the whole graph on each insertion.
+type IncrementalDAG[T comparable] struct {
+	graph *baseGraph[T]
+
+	// order stores the index of each vertex in the topological order.
+	// The indexes are unique, but not necessarily contiguous.
+	order map[T]int
+	next  int
+
+	// predecessors stores the incoming neighbors of each vertex, which
+	// are needed for the backward search.
+	predecessors map[T][]*Vertex[T]
+}
+
+// NewIncrementalDAG creates an empty IncrementalDAG. The underlying graph is
+// directed and accepts the additional graph options such as Weighted.
+func NewIncrementalDAG[T comparable](options ...GraphOptionFunc) *IncrementalDAG[T] {
+	properties := newProperties(options...)
+	properties.isDirected = true
+
+	// the acyclic property is guaranteed by the IncrementalDAG itself
+	properties.isAcyclic = false
+
+	return &IncrementalDAG[T]{
+		graph:        newBaseGraph[T](properties),
+		order:        make(map[T]int),
+		predecessors: make(map[T][]*Vertex[T]),
+	}
+}
+
+// AddVertexByLabel adds a new vertex with the given label to the end of
+// the topological order. If the vertex already exists, it returns the
+// existing vertex.
+func (d *IncrementalDAG[T]) AddVertexByLabel(label T, options ...VertexOptionFunc) *Vertex[T] {
+	if v := d.graph.findVertex(label); v != nil {
+		return v
+	}
+
+	d.order[label] = d.next
+	d.next++
+
+	return d.graph.AddVertexByLabel(label, options...)
+}
+
+// AddEdge adds an edge from the vertex with the 'from' label to the vertex
+// with the 'to' label, and creates the vertices if they don't exist.
+//
+// It returns ErrEdgeAlreadyExists if the edge already exists, and
+// ErrDAGHasCycle if the edge would create a cycle, in which case the
+// graph is not modified.
+func (d *IncrementalDAG[T]) AddEdge(from, to T, options ...EdgeOptionFunc) (*Edge[T], error) {
+	vFrom := d.AddVertexByLabel(from)
+	vTo := d.AddVertexByLabel(to)
+
+	if d.graph.ContainsEdge(vFrom, vTo) {
+		return nil, ErrEdgeAlreadyExists
+	}
+
+	if from == to {
+		return nil, ErrDAGHasCycle
+	}
+
+	lower, upper := d.order[to], d.order[from]
+	if lower < upper {
+		// Find the vertices reachable from 'to' that must be moved after
+		// 'from'. Reaching 'from' means the new edge creates a cycle.
+		forward, ok := d.forward(vTo, upper)
+		if !ok {
+			return nil, ErrDAGHasCycle
+		}
+
+		// Find the vertices reaching 'from' that must be moved before 'to'
+		backward := d.backward(vFrom, lower)
+
+		d.reorder(backward, forward)
+	}
+
+	edge, err := d.graph.AddEdge(vFrom, vTo, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.predecessors[to] = append(d.predecessors[to], vFrom)
+
+	return edge, nil
+}
+
+// forward returns the vertices reachable from the specified vertex, whose
+// index is not greater than the upper bound. It returns false if it reaches
+// the vertex at the upper bound, which means there is a cycle.
+func (d *IncrementalDAG[T]) forward(start *Vertex[T], upper int) ([]*Vertex[T], bool) {
+	visited := map[T]bool{start.label: true}
+	stack := []*Vertex[T]{start}
+	var out []*Vertex[T]
+	for len(stack) > 0 {
+		curr := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		out = append(out, curr)
+
+		for _, neighbor := range curr.adjacent() {
+			index := d.order[neighbor.label]
+			if index == upper {
+				return nil, false
+			}
+
+			if !visited[neighbor.label] && index < upper {
+				visited[neighbor.label] = true
+				stack = append(stack, neighbor)
+			}
+		}
+	}
+
+	return out, true
+}
+
+// backward returns the vertices reaching the specified vertex, whose index
+// is not less than the lower bound.
+func (d *IncrementalDAG[T]) backward(start *Vertex[T], lower int) []*Vertex[T] {
+	visited := map[T]bool{start.label: true}
+	stack := []*Vertex[T]{start}
+	var out []*Vertex[T]
+	for len(stack) > 0 {
+		curr := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		out = append(out, curr)
+
+		for _, pred := range d.predecessors[curr.label] {
+			if !visited[pred.label] && d.order[pred.label] > lower {
+				visited[pred.label] = true
+				stack = append(stack, pred)
+			}
+		}
+	}
+
+	return out
+}
+
+// reorder reuses the indexes of the affected vertices, so that all the
+// backward vertices come before the forward vertices, while keeping the
+// relative order inside each group.
+func (d *IncrementalDAG[T]) reorder(backward, forward []*Vertex[T]) {
+	byOrder := func(vertices []*Vertex[T]) {
+		sort.Slice(vertices, func(i, j int) bool {
+			return d.order[vertices[i].label] < d.order[vertices[j].label]
+		})
+	}
+	byOrder(backward)
+	byOrder(forward)
+
+	affected := append(backward, forward...)
+	indexes := make([]int, 0, len(affected))
+	for _, v := range affected {
+		indexes = append(indexes, d.order[v.label])
+	}
+	sort.Ints(indexes)
+
+	for i, v := range affected {
+		d.order[v.label] = indexes[i]
+	}
+}
+
+// TopologicalOrder returns all the vertices in a topological order.
+func (d *IncrementalDAG[T]) TopologicalOrder() []*Vertex[T] {
+	vertices := d.graph.GetAllVertices()
+	sort.Slice(vertices, func(i, j int) bool {
+		return d.order[vertices[i].label] < d.order[vertices[j].label]
+	})
+
+	return vertices
+}
+
+// Graph returns the underlying graph. The returned graph must not be
+// modified directly, otherwise the topological order is not maintained.
+func (d *IncrementalDAG[T]) Graph() Graph[T] {
+	return d.graph
+}
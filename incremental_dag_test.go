@@ -0,0 +1,115 @@
+package gograph
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestIncrementalDAG(t *testing.T) {
+	d := NewIncrementalDAG[int]()
+	naive := New[int](Acyclic())
+
+	// insert random edges, including the ones that create cycles, and
+	// compare the results with the naive acyclic graph.
+	rng := rand.New(rand.NewSource(42))
+	for i := 0; i < 500; i++ {
+		from, to := rng.Intn(40), rng.Intn(40)
+		if from == to {
+			continue
+		}
+
+		_, err := d.AddEdge(from, to)
+		_, naiveErr := naive.AddEdge(NewVertex(from), NewVertex(to))
+
+		switch {
+		case errors.Is(naiveErr, ErrDAGCycle):
+			if !errors.Is(err, ErrDAGHasCycle) {
+				t.Fatalf("edge %d->%d: expected %v, got %v", from, to, ErrDAGHasCycle, err)
+			}
+		case errors.Is(naiveErr, ErrEdgeAlreadyExists):
+			if !errors.Is(err, ErrEdgeAlreadyExists) {
+				t.Fatalf("edge %d->%d: expected %v, got %v", from, to, ErrEdgeAlreadyExists, err)
+			}
+		default:
+			if err != nil {
+				t.Fatalf("edge %d->%d: "+testErrMsgError, from, to, err)
+			}
+		}
+	}
+
+	if d.Graph().Size() != naive.Size() {
+		t.Errorf(testErrMsgNotEqual, naive.Size(), d.Graph().Size())
+	}
+
+	if _, err := TopologySort(d.Graph()); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	// every edge must go forward in the maintained order
+	order := d.TopologicalOrder()
+	index := make(map[int]int, len(order))
+	for i, v := range order {
+		index[v.Label()] = i
+	}
+
+	if len(order) != int(naive.Order()) {
+		t.Errorf(testErrMsgWrongLen, naive.Order(), len(order))
+	}
+
+	for _, edge := range d.Graph().AllEdges() {
+		if index[edge.Source().Label()] >= index[edge.Destination().Label()] {
+			t.Errorf("edge %v->%v goes backward in the topological order",
+				edge.Source().Label(), edge.Destination().Label())
+		}
+	}
+}
+
+func TestIncrementalDAGSelfLoop(t *testing.T) {
+	d := NewIncrementalDAG[string]()
+
+	if _, err := d.AddEdge("A", "A"); !errors.Is(err, ErrDAGHasCycle) {
+		t.Errorf(testErrMsgNotEqual, ErrDAGHasCycle, err)
+	}
+
+	if d.Graph().Size() != 0 {
+		t.Errorf(testErrMsgWrongLen, 0, d.Graph().Size())
+	}
+}
+
+func randomEdges(n, count int) [][2]int {
+	rng := rand.New(rand.NewSource(1))
+	edges := make([][2]int, 0, count)
+	for len(edges) < count {
+		from, to := rng.Intn(n), rng.Intn(n)
+		if from != to {
+			edges = append(edges, [2]int{from, to})
+		}
+	}
+
+	return edges
+}
+
+func BenchmarkIncrementalDAG_AddEdge(b *testing.B) {
+	edges := randomEdges(500, 2000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d := NewIncrementalDAG[int]()
+		for _, e := range edges {
+			_, _ = d.AddEdge(e[0], e[1])
+		}
+	}
+}
+
+func BenchmarkAcyclicGraph_AddEdge(b *testing.B) {
+	edges := randomEdges(500, 2000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g := New[int](Acyclic())
+		for _, e := range edges {
+			_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+		}
+	}
+}
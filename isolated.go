@@ -0,0 +1,28 @@
+package gograph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// IsolatedVertices returns the vertices with no incoming or outgoing edges,
+// which often indicate data issues.
+//
+// The result is sorted by the fmt representation of the labels, so the
+// order is deterministic regardless of how the graph was built.
+func IsolatedVertices[T comparable](g Graph[T]) []*Vertex[T] {
+	isolated := make([]*Vertex[T], 0)
+	vertices := g.GetAllVertices()
+	inDegrees := inDegreesOf(vertices)
+	for _, v := range vertices {
+		if inDegrees[v] == 0 && len(v.adjacent()) == 0 {
+			isolated = append(isolated, v)
+		}
+	}
+
+	sort.Slice(isolated, func(i, j int) bool {
+		return fmt.Sprint(isolated[i].label) < fmt.Sprint(isolated[j].label)
+	})
+
+	return isolated
+}
@@ -0,0 +1,31 @@
+package gograph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsolatedVertices(t *testing.T) {
+	g := New[string](Directed())
+	_, _ = g.AddEdge(NewVertex("A"), NewVertex("B"))
+	_, _ = g.AddEdge(NewVertex("B"), NewVertex("C"))
+	g.AddVertexByLabel("Z")
+	g.AddVertexByLabel("X")
+
+	// a vertex with a self-loop is not isolated
+	_, _ = g.AddEdge(NewVertex("L"), NewVertex("L"))
+
+	expected := []string{"X", "Z"}
+	for i := 0; i < 5; i++ {
+		got := extractLabels(IsolatedVertices(g))
+		if !reflect.DeepEqual(got, expected) {
+			t.Fatalf(testErrMsgNotEqual, expected, got)
+		}
+	}
+
+	undirected := New[int]()
+	_, _ = undirected.AddEdge(NewVertex(1), NewVertex(2))
+	if got := IsolatedVertices(undirected); len(got) != 0 {
+		t.Errorf(testErrMsgWrongLen, 0, len(got))
+	}
+}
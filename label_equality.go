@@ -0,0 +1,89 @@
+package gograph
+
+// labelEquality holds the user-defined equality of the labels of a graph.
+type labelEquality[T comparable] struct {
+	equal func(a, b T) bool
+	hash  func(T) uint64
+}
+
+// WithLabelEquality returns a GraphOptionFunc that makes the graph compare
+// the vertex labels by the specified equality function instead of Go's
+// native comparison, e.g. to use case-insensitive strings as labels. The
+// hash function must return the same value for equal labels.
+//
+// The graph keeps the label of the first vertex added among equal labels
+// as its canonical label. All the methods of the graph accept any equal
+// label, and the vertices returned by the graph have the canonical label.
+// For example, after adding the vertex "A" with a case-insensitive equality,
+// GetVertexByID("a") returns it, and adding the vertex "a" does nothing.
+//
+// The equality only applies to the methods of the graph itself. The
+// functions that keep their own maps of labels, such as the path algorithms,
+// the iterators, or Clone, compare labels natively, so they should be given
+// the canonical labels, e.g. g.GetVertexByID(label).Label().
+//
+// The option is ignored if T is not the label type of the created graph.
+func WithLabelEquality[T comparable](equal func(a, b T) bool, hash func(T) uint64) GraphOptionFunc {
+	return func(properties *GraphProperties) {
+		if equal != nil && hash != nil {
+			properties.labelEquality = labelEquality[T]{equal: equal, hash: hash}
+		}
+	}
+}
+
+// labelCanonicalizer maps the labels to the canonical labels of the vertices
+// of a graph, using a user-defined equality. It is a hash table from the
+// hash of a label to the canonical labels with that hash.
+type labelCanonicalizer[T comparable] struct {
+	labelEquality[T]
+	buckets map[uint64][]T
+}
+
+func newLabelCanonicalizer[T comparable](properties GraphProperties) *labelCanonicalizer[T] {
+	equality, ok := properties.labelEquality.(labelEquality[T])
+	if !ok {
+		return nil
+	}
+
+	return &labelCanonicalizer[T]{
+		labelEquality: equality,
+		buckets:       make(map[uint64][]T),
+	}
+}
+
+// canonical returns the canonical label equal to the input label, or the
+// input label itself if there is no such label.
+func (c *labelCanonicalizer[T]) canonical(label T) T {
+	for _, candidate := range c.buckets[c.hash(label)] {
+		if c.equal(candidate, label) {
+			return candidate
+		}
+	}
+
+	return label
+}
+
+// add registers the label as a canonical label.
+func (c *labelCanonicalizer[T]) add(label T) {
+	h := c.hash(label)
+	c.buckets[h] = append(c.buckets[h], label)
+}
+
+// remove unregisters the canonical label.
+func (c *labelCanonicalizer[T]) remove(label T) {
+	h := c.hash(label)
+	bucket := c.buckets[h]
+	for i, candidate := range bucket {
+		if candidate == label {
+			bucket = append(bucket[:i], bucket[i+1:]...)
+			break
+		}
+	}
+
+	if len(bucket) == 0 {
+		delete(c.buckets, h)
+		return
+	}
+
+	c.buckets[h] = bucket
+}
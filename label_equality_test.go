@@ -0,0 +1,80 @@
+package gograph
+
+import (
+	"hash/fnv"
+	"strings"
+	"testing"
+)
+
+func caseInsensitiveHash(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(strings.ToLower(s)))
+	return h.Sum64()
+}
+
+func TestWithLabelEquality(t *testing.T) {
+	g := New[string](Directed(), WithLabelEquality(strings.EqualFold, caseInsensitiveHash))
+
+	a := g.AddVertexByLabel("A")
+	if v := g.AddVertexByLabel("a"); v != a {
+		t.Errorf(testErrMsgNotEqual, a, v)
+	}
+
+	if g.GetVertexByID("a") != a {
+		t.Errorf(testErrMsgNotEqual, a, g.GetVertexByID("a"))
+	}
+
+	// the edge reuses the existing vertices
+	if _, err := g.AddEdge(NewVertex("a"), NewVertex("B")); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if _, err := g.AddEdge(NewVertex("A"), NewVertex("b")); err == nil {
+		t.Error(testErrMsgNoError)
+	}
+
+	if g.Order() != 2 || g.Size() != 1 {
+		t.Errorf("Expected 2 vertices and 1 edge, got %d and %d", g.Order(), g.Size())
+	}
+
+	if !g.HasEdge("a", "b") || !g.ContainsEdge(NewVertex("a"), NewVertex("b")) {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	edge := g.GetEdge(NewVertex("a"), NewVertex("b"))
+	if edge == nil || edge.Source() != a || edge.Destination().Label() != "B" {
+		t.Errorf("Expected edge A -> B, got %v", edge)
+	}
+
+	if len(g.EdgesOf(NewVertex("b"))) != 1 {
+		t.Errorf(testErrMsgWrongLen, 1, len(g.EdgesOf(NewVertex("b"))))
+	}
+
+	g.RemoveEdges(NewEdge(NewVertex("a"), NewVertex("b")))
+	if g.Size() != 0 || a.OutDegree() != 0 {
+		t.Errorf(testErrMsgWrongLen, 0, g.Size())
+	}
+
+	if err := g.RemoveVerticesByLabel("a"); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if g.GetVertexByID("A") != nil || g.Order() != 1 {
+		t.Error("Expected the vertex to be removed")
+	}
+
+	// after the removal, the other case becomes the canonical label
+	g.AddVertexByLabel("a")
+	if v := g.GetVertexByID("A"); v == nil || v.Label() != "a" {
+		t.Errorf(testErrMsgNotEqual, "a", v)
+	}
+}
+
+func TestWithLabelEqualityMismatchedType(t *testing.T) {
+	// the option for another label type is ignored
+	g := New[int](WithLabelEquality(strings.EqualFold, caseInsensitiveHash))
+	g.AddVertexByLabel(1)
+	if g.AddVertexByLabel(2) == nil || g.Order() != 2 {
+		t.Errorf(testErrMsgWrongLen, 2, g.Order())
+	}
+}
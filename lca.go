@@ -0,0 +1,97 @@
+package gograph
+
+// LowestCommonAncestors returns the lowest common ancestors of the vertices
+// with labels 'a' and 'b' in a directed acyclic graph. A lowest common
+// ancestor is a common ancestor that has no descendant which is also a
+// common ancestor. Unlike trees, a DAG can have multiple lowest common
+// ancestors for a pair of vertices.
+//
+// Each vertex is considered an ancestor of itself, so if 'a' is an ancestor
+// of 'b', the result is 'a'. The result is in topological order, and it is
+// empty if the vertices have no common ancestor.
+//
+// It returns ErrVertexDoesNotExist if any of the vertices doesn't exist, and
+// ErrDAGHasCycle if the graph contains a cycle.
+func LowestCommonAncestors[T comparable](g Graph[T], a, b T) ([]*Vertex[T], error) {
+	va := g.GetVertexByID(a)
+	vb := g.GetVertexByID(b)
+	if va == nil || vb == nil {
+		return nil, ErrVertexDoesNotExist
+	}
+
+	sorted, err := TopologySort(g)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the reverse adjacency to walk from a vertex to its ancestors
+	predecessors := make(map[T][]*Vertex[T])
+	for _, v := range sorted {
+		for _, neighbor := range v.adjacent() {
+			predecessors[neighbor.label] = append(predecessors[neighbor.label], v)
+		}
+	}
+
+	ancestorsOfA := ancestors(va, predecessors)
+	ancestorsOfB := ancestors(vb, predecessors)
+
+	common := make(map[T]bool)
+	for label := range ancestorsOfA {
+		if ancestorsOfB[label] {
+			common[label] = true
+		}
+	}
+
+	// Remove the common ancestors that have a descendant which is
+	// also a common ancestor.
+	nonMinimal := make(map[T]bool)
+	for label := range common {
+		visited := make(map[T]bool)
+		stack := append([]*Vertex[T](nil), g.GetVertexByID(label).adjacent()...)
+		for len(stack) > 0 {
+			curr := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if visited[curr.label] {
+				continue
+			}
+			visited[curr.label] = true
+
+			if common[curr.label] {
+				nonMinimal[label] = true
+				break
+			}
+
+			stack = append(stack, curr.adjacent()...)
+		}
+	}
+
+	lcas := make([]*Vertex[T], 0)
+	for _, v := range sorted {
+		if common[v.label] && !nonMinimal[v.label] {
+			lcas = append(lcas, v)
+		}
+	}
+
+	return lcas, nil
+}
+
+// ancestors returns the labels of all the vertices that reach the specified
+// vertex, including the vertex itself.
+func ancestors[T comparable](v *Vertex[T], predecessors map[T][]*Vertex[T]) map[T]bool {
+	visited := map[T]bool{v.label: true}
+	stack := []*Vertex[T]{v}
+	for len(stack) > 0 {
+		curr := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, pred := range predecessors[curr.label] {
+			if !visited[pred.label] {
+				visited[pred.label] = true
+				stack = append(stack, pred)
+			}
+		}
+	}
+
+	return visited
+}
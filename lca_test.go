@@ -0,0 +1,88 @@
+package gograph
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestLowestCommonAncestors(t *testing.T) {
+	// 1 and 2 are both lowest common ancestors of 5 and 6:
+	//
+	//   0
+	//  / \
+	// 1   2
+	// |\ /|
+	// | X |
+	// |/ \|
+	// 3   4
+	// |   |
+	// 5   6
+	g := New[int](Acyclic())
+	edges := [][2]int{{0, 1}, {0, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 5}, {4, 6}}
+	for _, e := range edges {
+		_, err := g.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+		if err != nil {
+			t.Fatalf(testErrMsgError, err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		a, b     int
+		expected []int
+	}{
+		{name: "two lcas", a: 5, b: 6, expected: []int{1, 2}},
+		{name: "single lca", a: 1, b: 2, expected: []int{0}},
+		{name: "ancestor of the other", a: 1, b: 5, expected: []int{1}},
+		{name: "same vertex", a: 3, b: 3, expected: []int{3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lcas, err := LowestCommonAncestors(g, tt.a, tt.b)
+			if err != nil {
+				t.Fatalf(testErrMsgError, err)
+			}
+
+			labels := extractLabels(lcas)
+			sort.Ints(labels)
+			if !reflect.DeepEqual(labels, tt.expected) {
+				t.Errorf(testErrMsgNotEqual, tt.expected, labels)
+			}
+		})
+	}
+}
+
+func TestLowestCommonAncestorsErrors(t *testing.T) {
+	g := New[int](Directed())
+	_, _ = g.AddEdge(NewVertex(1), NewVertex(2))
+	_, _ = g.AddEdge(NewVertex(2), NewVertex(1))
+	_, _ = g.AddEdge(NewVertex(3), NewVertex(1))
+
+	_, err := LowestCommonAncestors(g, 1, 4)
+	if !errors.Is(err, ErrVertexDoesNotExist) {
+		t.Errorf(testErrMsgNotEqual, ErrVertexDoesNotExist, err)
+	}
+
+	_, err = LowestCommonAncestors(g, 1, 3)
+	if !errors.Is(err, ErrDAGHasCycle) {
+		t.Errorf(testErrMsgNotEqual, ErrDAGHasCycle, err)
+	}
+}
+
+func TestLowestCommonAncestorsNoCommonAncestor(t *testing.T) {
+	g := New[int](Acyclic())
+	_, _ = g.AddEdge(NewVertex(1), NewVertex(2))
+	_, _ = g.AddEdge(NewVertex(3), NewVertex(4))
+
+	lcas, err := LowestCommonAncestors(g, 2, 4)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if len(lcas) != 0 {
+		t.Errorf(testErrMsgWrongLen, 0, len(lcas))
+	}
+}
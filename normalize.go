@@ -0,0 +1,71 @@
+package gograph
+
+import "math"
+
+// NormalizeWeights linearly rescales all the edge weights of the graph into
+// the range of [0, 1], so the minimum weight maps to 0 and the maximum weight
+// maps to 1, while the relative order of the weights is preserved. If all the
+// weights are equal, they all become 1. It modifies the input graph, use
+// Normalized to get a normalized copy instead.
+//
+// It returns ErrNotWeighted if the graph is not weighted, ErrReadOnlyGraph
+// if the graph is read-only, such as a Snapshot or a FilteredView, and
+// ErrNonFiniteWeight if any weight is infinite or NaN, in which case the
+// graph is not modified.
+func NormalizeWeights[T comparable](g Graph[T]) error {
+	if !g.IsWeighted() {
+		return ErrNotWeighted
+	}
+
+	if r, ok := g.(interface{ readOnly() bool }); ok && r.readOnly() {
+		return ErrReadOnlyGraph
+	}
+
+	edges := g.AllEdges()
+	if len(edges) == 0 {
+		return nil
+	}
+
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, edge := range edges {
+		w := edge.Weight()
+		if math.IsInf(w, 0) || math.IsNaN(w) {
+			return ErrNonFiniteWeight
+		}
+
+		low = math.Min(low, w)
+		high = math.Max(high, w)
+	}
+
+	for _, edge := range edges {
+		if high == low {
+			edge.properties.weight = 1
+			continue
+		}
+
+		// the halves keep the range finite for the extreme finite weights,
+		// as in WeightHistogram
+		edge.properties.weight = (edge.properties.weight/2 - low/2) / (high/2 - low/2)
+	}
+
+	return nil
+}
+
+// Normalized returns a copy of the graph, whose edge weights are rescaled
+// into the range of [0, 1] in the same way as NormalizeWeights. The input
+// graph is not modified.
+//
+// It returns ErrNotWeighted if the graph is not weighted, and
+// ErrNonFiniteWeight if any weight is infinite or NaN.
+func Normalized[T comparable](g Graph[T]) (Graph[T], error) {
+	if !g.IsWeighted() {
+		return nil, ErrNotWeighted
+	}
+
+	clone := Clone(g)
+	if err := NormalizeWeights(clone); err != nil {
+		return nil, err
+	}
+
+	return clone, nil
+}
@@ -0,0 +1,137 @@
+package gograph
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"testing"
+)
+
+func TestNormalizeWeights(t *testing.T) {
+	g := New[string](Directed(), Weighted())
+	weights := map[[2]string]float64{{"A", "B"}: -2, {"B", "C"}: 3, {"C", "D"}: 8, {"A", "D"}: 0.5}
+	for e, w := range weights {
+		_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]), WithEdgeWeight(w))
+	}
+
+	normalized, err := Normalized(g)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	// the input graph is untouched by Normalized
+	if w := g.GetEdge(g.GetVertexByID("C"), g.GetVertexByID("D")).Weight(); w != 8 {
+		t.Errorf(testErrMsgNotEqual, 8, w)
+	}
+
+	if err = NormalizeWeights(g); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	for _, graph := range []Graph[string]{g, normalized} {
+		edges := graph.AllEdges()
+		sort.Slice(edges, func(i, j int) bool {
+			return weights[[2]string{edges[i].source.label, edges[i].dest.label}] <
+				weights[[2]string{edges[j].source.label, edges[j].dest.label}]
+		})
+
+		if edges[0].Weight() != 0 || edges[len(edges)-1].Weight() != 1 {
+			t.Errorf("expected weights in [0, 1], got %v and %v", edges[0].Weight(), edges[len(edges)-1].Weight())
+		}
+
+		for i := 1; i < len(edges); i++ {
+			if edges[i-1].Weight() >= edges[i].Weight() {
+				t.Errorf("expected the order of weights to be preserved")
+			}
+		}
+
+		if w := graph.GetEdge(graph.GetVertexByID("B"), graph.GetVertexByID("C")).Weight(); math.Abs(w-0.5) > 1e-9 {
+			t.Errorf(testErrMsgNotEqual, 0.5, w)
+		}
+	}
+}
+
+func TestNormalizeWeightsDegenerate(t *testing.T) {
+	g := New[int](Weighted())
+	_, _ = g.AddEdge(NewVertex(1), NewVertex(2), WithEdgeWeight(4))
+	_, _ = g.AddEdge(NewVertex(2), NewVertex(3), WithEdgeWeight(4))
+
+	if err := NormalizeWeights(g); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	for _, edge := range g.AllEdges() {
+		if edge.Weight() != 1 {
+			t.Errorf(testErrMsgNotEqual, 1, edge.Weight())
+		}
+	}
+}
+
+func TestNormalizeWeightsExtremeWeights(t *testing.T) {
+	g := New[int](Directed(), Weighted())
+	_, _ = g.AddEdge(NewVertex(1), NewVertex(2), WithEdgeWeight(-math.MaxFloat64))
+	_, _ = g.AddEdge(NewVertex(2), NewVertex(3), WithEdgeWeight(0))
+	_, _ = g.AddEdge(NewVertex(3), NewVertex(4), WithEdgeWeight(math.MaxFloat64))
+
+	if err := NormalizeWeights(g); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	for from, expected := range map[int]float64{1: 0, 2: 0.5, 3: 1} {
+		if w := g.GetEdge(NewVertex(from), NewVertex(from+1)).Weight(); w != expected {
+			t.Errorf(testErrMsgNotEqual, expected, w)
+		}
+	}
+
+	// the normalized weights are finite
+	if _, err := WeightHistogram(g, 2); err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+}
+
+func TestNormalizeWeightsErrors(t *testing.T) {
+	if err := NormalizeWeights(New[int]()); !errors.Is(err, ErrNotWeighted) {
+		t.Errorf(testErrMsgNotEqual, ErrNotWeighted, err)
+	}
+
+	g := New[int](Weighted())
+	_, _ = g.AddEdge(NewVertex(1), NewVertex(2), WithEdgeWeight(math.Inf(1)))
+	_, _ = g.AddEdge(NewVertex(2), NewVertex(3), WithEdgeWeight(2))
+	if _, err := Normalized(g); !errors.Is(err, ErrNonFiniteWeight) {
+		t.Errorf(testErrMsgNotEqual, ErrNonFiniteWeight, err)
+	}
+}
+
+func TestNormalizeWeightsReadOnly(t *testing.T) {
+	g := New[string](Directed(), Weighted())
+	_, _ = g.AddEdge(NewVertex("A"), NewVertex("B"), WithEdgeWeight(2))
+	_, _ = g.AddEdge(NewVertex("B"), NewVertex("C"), WithEdgeWeight(8))
+
+	snapshot := Snapshot(g)
+	view := FilteredView[string](g, nil, nil)
+
+	// the types that embed a read-only graph are read-only too
+	wrapped := struct{ *snapshotGraph[string] }{snapshot.(*snapshotGraph[string])}
+	for name, graph := range map[string]Graph[string]{"snapshot": snapshot, "view": view, "wrapped": wrapped} {
+		if err := NormalizeWeights(graph); !errors.Is(err, ErrReadOnlyGraph) {
+			t.Errorf("%s: "+testErrMsgNotEqual, name, ErrReadOnlyGraph, err)
+		}
+	}
+
+	// neither the wrappers nor the underlying graph are modified
+	for _, graph := range []Graph[string]{g, snapshot, view} {
+		if w := graph.GetEdge(NewVertex("B"), NewVertex("C")).Weight(); w != 8 {
+			t.Errorf(testErrMsgNotEqual, 8, w)
+		}
+	}
+
+	// a normalized copy of a wrapper can still be made
+	normalized, err := Normalized(view)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if w := normalized.GetEdge(NewVertex("B"), NewVertex("C")).Weight(); w != 1 {
+		t.Errorf(testErrMsgNotEqual, 1, w)
+	}
+}
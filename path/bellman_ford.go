@@ -9,8 +9,8 @@ import (
 
 var (
 	ErrNegativeWeightCycle = errors.New("graph contains negative weight cycle")
-	ErrNotDirected         = errors.New("graph is not directed")
-	ErrNotWeighted         = errors.New("graph is not weighted")
+	ErrNotDirected         = gograph.ErrNotDirected
+	ErrNotWeighted         = gograph.ErrNotWeighted
 )
 
 // BellmanFord finds the shortest path from a source vertex to all other vertices
@@ -38,8 +38,13 @@ var (
 //		distances from the source vertex to all other vertices. If there is a negative weight
 //		cycle, the algorithm typically returns an indication of this fact.
 //
+// The relaxation stops early, when a whole iteration doesn't improve any distance, since the
+// following iterations wouldn't either. In that case there is no negative weight cycle reachable
+// from the source, so the detection pass is skipped.
+//
 // The time complexity of the Bellman-Ford algorithm is O(V*E), where V is the number of vertices
-// and E is the number of edges.
+// and E is the number of edges. With the early termination, it is O(k*E), where k is the number
+// of edges of the shortest path with the most edges, which is much smaller than V in the common case.
 func BellmanFord[T comparable](g gograph.Graph[T], start T) (map[T]float64, error) {
 	if !g.IsWeighted() {
 		return nil, ErrNotWeighted
@@ -60,13 +65,19 @@ func BellmanFord[T comparable](g gograph.Graph[T], start T) (map[T]float64, erro
 
 	dist[start] = 0
 	for i := 1; i < len(vertices); i++ {
+		changed := false
 		for _, edge := range edges {
 			weight := edge.Weight()
 			if dist[edge.Source().Label()] != maxValue &&
 				dist[edge.Source().Label()]+weight < dist[edge.Destination().Label()] {
 				dist[edge.Destination().Label()] = dist[edge.Source().Label()] + weight
+				changed = true
 			}
 		}
+
+		if !changed {
+			return dist, nil
+		}
 	}
 
 	for _, edge := range edges {
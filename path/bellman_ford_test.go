@@ -2,6 +2,8 @@ package path
 
 import (
 	"errors"
+	"math"
+	"math/rand"
 	"testing"
 
 	"github.com/gavinhailey/gograph"
@@ -124,7 +126,7 @@ func TestBellmanFord_NotWeighted(t *testing.T) {
 		t.Errorf("Expected error, but got nil")
 	}
 
-	if !errors.Is(err, ErrNotWeighted) {
+	if !errors.Is(err, ErrNotWeighted) || !errors.Is(err, gograph.ErrNotWeighted) {
 		t.Errorf("Expected error \"%s\", but got \"%s\"", ErrNotWeighted, err)
 	}
 }
@@ -144,7 +146,88 @@ func TestBellmanFord_NotDirected(t *testing.T) {
 		t.Errorf("Expected error, but got nil")
 	}
 
-	if !errors.Is(err, ErrNotDirected) {
+	if !errors.Is(err, ErrNotDirected) || !errors.Is(err, gograph.ErrNotDirected) {
 		t.Errorf("Expected error \"%s\", but got \"%s\"", ErrNotDirected, err)
 	}
 }
+
+func TestBellmanFord_SameAsNaive(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	for round := 0; round < 20; round++ {
+		// the lower the minimum weight, the more likely a negative cycle is
+		g := newRandomBellmanFordGraph(rng, 40, 120, -round%4)
+
+		expected, expectedErr := bellmanFordNaive(g, 0)
+		actual, err := BellmanFord(g, 0)
+		if !errors.Is(err, expectedErr) {
+			t.Fatalf("Expected error \"%v\", but got \"%v\"", expectedErr, err)
+		}
+
+		for label, d := range expected {
+			if actual[label] != d {
+				t.Errorf("Expected distance of %d to be %v, but got %v", label, d, actual[label])
+			}
+		}
+	}
+}
+
+func BenchmarkBellmanFord(b *testing.B) {
+	g := newRandomBellmanFordGraph(rand.New(rand.NewSource(5)), 1000, 5000, 0)
+
+	b.Run("early termination", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = BellmanFord(g, 0)
+		}
+	})
+
+	b.Run("naive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = bellmanFordNaive(g, 0)
+		}
+	})
+}
+
+// newRandomBellmanFordGraph creates a random weighted directed graph, whose
+// edge weights are in the range of [minWeight, minWeight+10).
+func newRandomBellmanFordGraph(rng *rand.Rand, vertices, edges, minWeight int) gograph.Graph[int] {
+	g := gograph.New[int](gograph.Weighted(), gograph.Directed())
+	for i := 0; i < vertices; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 0; i < edges; i++ {
+		from, to := g.GetVertexByID(rng.Intn(vertices)), g.GetVertexByID(rng.Intn(vertices))
+		_, _ = g.AddEdge(from, to, gograph.WithEdgeWeight(float64(minWeight+rng.Intn(10))))
+	}
+
+	return g
+}
+
+// bellmanFordNaive always runs all the |V| - 1 iterations, to verify the
+// early termination of BellmanFord.
+func bellmanFordNaive[T comparable](g gograph.Graph[T], start T) (map[T]float64, error) {
+	vertices := g.GetAllVertices()
+	edges := g.AllEdges()
+
+	dist := make(map[T]float64)
+	for _, v := range vertices {
+		dist[v.Label()] = math.Inf(1)
+	}
+
+	dist[start] = 0
+	for i := 1; i < len(vertices); i++ {
+		for _, edge := range edges {
+			if d := dist[edge.Source().Label()] + edge.Weight(); d < dist[edge.Destination().Label()] {
+				dist[edge.Destination().Label()] = d
+			}
+		}
+	}
+
+	for _, edge := range edges {
+		if dist[edge.Source().Label()]+edge.Weight() < dist[edge.Destination().Label()] {
+			return nil, ErrNegativeWeightCycle
+		}
+	}
+
+	return dist, nil
+}
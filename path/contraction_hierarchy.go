@@ -0,0 +1,414 @@
+package path
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+
+	"github.com/gavinhailey/gograph"
+)
+
+var (
+	// ErrNegativeEdgeWeight is returned when a function requires all the
+	// edge weights to be non-negative.
+	ErrNegativeEdgeWeight = errors.New("graph has negative edge weight")
+)
+
+// witnessSearchLimit bounds the number of vertices settled by each witness
+// search during the preprocessing. A search that hits the limit adds a
+// shortcut that may be unnecessary, which is still correct.
+const witnessSearchLimit = 500
+
+// CH is a contraction hierarchy of a weighted graph, which answers point to
+// point shortest path queries much faster than Dijkstra, after a one-time
+// preprocessing. It is suited for many queries on a static graph, like a
+// road network.
+//
+// The hierarchy is a snapshot of the graph at the time it is built, so it
+// must be rebuilt after the graph changes.
+type CH[T comparable] struct {
+	graph    gograph.Graph[T]
+	labels   []T
+	index    map[T]int
+	edges    map[[2]int]chEdge // all the original edges and shortcuts
+	upward   [][]chArc         // the edges to higher ranked vertices
+	downward [][]chArc         // the reversed edges from higher ranked vertices
+}
+
+// chEdge is an original edge or a shortcut. The middle vertex of a shortcut
+// is the contracted vertex it bypasses, and it is -1 for an original edge.
+type chEdge struct {
+	weight float64
+	middle int
+}
+
+// chArc is an edge of the search graphs of the queries.
+type chArc struct {
+	to     int
+	weight float64
+}
+
+// BuildContractionHierarchy preprocesses the weighted graph into a
+// contraction hierarchy. It contracts the vertices one by one in order of
+// importance: contracting a vertex removes it from the remaining graph, and
+// adds a shortcut between each pair of its remaining neighbors whose
+// shortest path goes through it, unless a local Dijkstra search, the
+// witness search, finds another path that is not longer. The importance of
+// a vertex is the number of shortcuts its contraction adds minus the number
+// of edges it removes, plus the number of its already contracted neighbors,
+// which spreads the contraction uniformly over the graph. The priorities are
+// updated lazily.
+//
+// The preprocessing cost depends on the structure of the graph. On sparse,
+// road-like graphs, it is close to O(V log V) witness searches, and the
+// number of shortcuts is in the order of the number of edges. On dense or
+// highly connected graphs, it can be much slower and add many shortcuts.
+//
+// In undirected graphs, each edge is used in both directions. Self-loops
+// are ignored. It returns ErrNotWeighted if the graph is not weighted, and
+// ErrNegativeEdgeWeight if any edge has a negative weight.
+func BuildContractionHierarchy[T comparable](g gograph.Graph[T]) (*CH[T], error) {
+	if !g.IsWeighted() {
+		return nil, ErrNotWeighted
+	}
+
+	vertices := g.GetAllVertices()
+	ch := &CH[T]{
+		graph:  g,
+		labels: make([]T, len(vertices)),
+		index:  make(map[T]int, len(vertices)),
+		edges:  make(map[[2]int]chEdge),
+	}
+
+	for i, v := range vertices {
+		ch.labels[i] = v.Label()
+		ch.index[v.Label()] = i
+	}
+
+	// out and in are the adjacency of the remaining graph
+	n := len(vertices)
+	out := make([]map[int]float64, n)
+	in := make([]map[int]float64, n)
+	for i := range out {
+		out[i] = make(map[int]float64)
+		in[i] = make(map[int]float64)
+	}
+
+	for _, edge := range g.AllEdges() {
+		if edge.Weight() < 0 {
+			return nil, ErrNegativeEdgeWeight
+		}
+
+		from, to := ch.index[edge.Source().Label()], ch.index[edge.Destination().Label()]
+		if from == to {
+			continue
+		}
+
+		out[from][to] = edge.Weight()
+		in[to][from] = edge.Weight()
+		ch.edges[[2]int{from, to}] = chEdge{weight: edge.Weight(), middle: -1}
+	}
+
+	c := &contractor{out: out, in: in, contracted: make([]bool, n), deleted: make([]int, n)}
+
+	pq := &chQueue{}
+	for v := 0; v < n; v++ {
+		heap.Push(pq, chItem{vertex: v, priority: c.importance(v)})
+	}
+
+	rank := make([]int, n)
+	for next := 0; pq.Len() > 0; {
+		item, _ := heap.Pop(pq).(chItem)
+
+		// lazy update: contract the vertex only if it is still the least important
+		priority := c.importance(item.vertex)
+		if pq.Len() > 0 && priority > (*pq)[0].priority {
+			heap.Push(pq, chItem{vertex: item.vertex, priority: priority})
+			continue
+		}
+
+		for _, shortcut := range c.contract(item.vertex, false) {
+			key := [2]int{shortcut.from, shortcut.to}
+			if existing, ok := ch.edges[key]; !ok || shortcut.weight < existing.weight {
+				ch.edges[key] = chEdge{weight: shortcut.weight, middle: item.vertex}
+			}
+		}
+
+		rank[item.vertex] = next
+		next++
+	}
+
+	ch.upward = make([][]chArc, n)
+	ch.downward = make([][]chArc, n)
+	for key, edge := range ch.edges {
+		from, to := key[0], key[1]
+		if rank[from] < rank[to] {
+			ch.upward[from] = append(ch.upward[from], chArc{to: to, weight: edge.weight})
+		} else {
+			ch.downward[to] = append(ch.downward[to], chArc{to: from, weight: edge.weight})
+		}
+	}
+
+	return ch, nil
+}
+
+// Query returns the shortest path from the 'from' vertex to the 'to' vertex,
+// and its cost. It runs a bidirectional Dijkstra, which only follows the
+// edges to higher ranked vertices from both ends, so it settles a small
+// fraction of the vertices, and then unpacks the shortcuts of the path.
+//
+// It returns gograph.ErrVertexDoesNotExist if any of the vertices doesn't
+// exist in the hierarchy, and ErrNoPath if the 'to' vertex is not reachable.
+func (ch *CH[T]) Query(from, to T) ([]*gograph.Vertex[T], float64, error) {
+	source, ok := ch.index[from]
+	if !ok {
+		return nil, 0, gograph.ErrVertexDoesNotExist
+	}
+
+	target, ok := ch.index[to]
+	if !ok {
+		return nil, 0, gograph.ErrVertexDoesNotExist
+	}
+
+	forward := newCHSearch(source)
+	backward := newCHSearch(target)
+
+	best, meeting := math.Inf(1), -1
+	for forward.queue.Len() > 0 || backward.queue.Len() > 0 {
+		for _, search := range []struct {
+			s     *chSearch
+			other *chSearch
+			arcs  [][]chArc
+		}{{forward, backward, ch.upward}, {backward, forward, ch.downward}} {
+			if search.s.queue.Len() == 0 {
+				continue
+			}
+
+			// the search can't improve the best distance anymore
+			if (*search.s.queue)[0].priority >= best {
+				*search.s.queue = (*search.s.queue)[:0]
+				continue
+			}
+
+			v, ok := search.s.settle(search.arcs)
+			if !ok {
+				continue
+			}
+
+			if d, ok := search.other.dist[v]; ok && search.s.dist[v]+d < best {
+				best, meeting = search.s.dist[v]+d, v
+			}
+		}
+	}
+
+	if meeting == -1 {
+		return nil, 0, ErrNoPath
+	}
+
+	// the path is source -> meeting in the forward search, followed by
+	// meeting -> target in the backward search, with shortcuts unpacked.
+	var up []int
+	for v := meeting; v != source; v = forward.prev[v] {
+		up = append(up, v)
+	}
+	up = append(up, source)
+
+	vertices := []int{source}
+	for i := len(up) - 1; i > 0; i-- {
+		vertices = ch.unpack(up[i], up[i-1], vertices)
+	}
+
+	for v := meeting; v != target; v = backward.prev[v] {
+		vertices = ch.unpack(v, backward.prev[v], vertices)
+	}
+
+	path := make([]*gograph.Vertex[T], len(vertices))
+	for i, v := range vertices {
+		path[i] = ch.graph.GetVertexByID(ch.labels[v])
+	}
+
+	return path, best, nil
+}
+
+// unpack appends the vertices of the edge from u to w after u to the path,
+// replacing each shortcut by the edges it bypasses.
+func (ch *CH[T]) unpack(u, w int, path []int) []int {
+	edge := ch.edges[[2]int{u, w}]
+	if edge.middle == -1 {
+		return append(path, w)
+	}
+
+	path = ch.unpack(u, edge.middle, path)
+	return ch.unpack(edge.middle, w, path)
+}
+
+// chSearch is one direction of the bidirectional query.
+type chSearch struct {
+	dist    map[int]float64
+	prev    map[int]int
+	settled map[int]bool
+	queue   *chQueue
+}
+
+func newCHSearch(start int) *chSearch {
+	s := &chSearch{
+		dist:    map[int]float64{start: 0},
+		prev:    make(map[int]int),
+		settled: make(map[int]bool),
+		queue:   &chQueue{{vertex: start, priority: 0}},
+	}
+
+	return s
+}
+
+// settle pops the closest vertex and relaxes its arcs. It reports false if
+// the popped vertex has already been settled.
+func (s *chSearch) settle(arcs [][]chArc) (int, bool) {
+	item, _ := heap.Pop(s.queue).(chItem)
+	if s.settled[item.vertex] {
+		return 0, false
+	}
+	s.settled[item.vertex] = true
+
+	for _, arc := range arcs[item.vertex] {
+		d := item.priority + arc.weight
+		if current, ok := s.dist[arc.to]; !ok || d < current {
+			s.dist[arc.to] = d
+			s.prev[arc.to] = item.vertex
+			heap.Push(s.queue, chItem{vertex: arc.to, priority: d})
+		}
+	}
+
+	return item.vertex, true
+}
+
+// contractor holds the remaining graph during the preprocessing.
+type contractor struct {
+	out, in    []map[int]float64
+	contracted []bool
+	deleted    []int // the number of contracted neighbors of each vertex
+}
+
+type chShortcut struct {
+	from, to int
+	weight   float64
+}
+
+// importance returns the priority of the vertex for the contraction, the
+// lower the sooner.
+func (c *contractor) importance(v int) float64 {
+	shortcuts := len(c.contract(v, true))
+	return float64(shortcuts-len(c.out[v])-len(c.in[v])) + float64(c.deleted[v])
+}
+
+// contract returns the shortcuts needed to contract the vertex. Unless
+// simulate is true, it also adds them to the remaining graph and removes
+// the vertex from it.
+func (c *contractor) contract(v int, simulate bool) []chShortcut {
+	var shortcuts []chShortcut
+	for u, inWeight := range c.in[v] {
+		var maxCost float64
+		for w, outWeight := range c.out[v] {
+			if w != u {
+				maxCost = max(maxCost, inWeight+outWeight)
+			}
+		}
+
+		dist := c.witnessSearch(u, v, maxCost)
+		for w, outWeight := range c.out[v] {
+			if w == u {
+				continue
+			}
+
+			cost := inWeight + outWeight
+			if d, ok := dist[w]; ok && d <= cost {
+				continue
+			}
+
+			shortcuts = append(shortcuts, chShortcut{from: u, to: w, weight: cost})
+		}
+	}
+
+	if simulate {
+		return shortcuts
+	}
+
+	for _, s := range shortcuts {
+		if existing, ok := c.out[s.from][s.to]; !ok || s.weight < existing {
+			c.out[s.from][s.to] = s.weight
+			c.in[s.to][s.from] = s.weight
+		}
+	}
+
+	for u := range c.in[v] {
+		delete(c.out[u], v)
+		c.deleted[u]++
+	}
+
+	for w := range c.out[v] {
+		delete(c.in[w], v)
+		c.deleted[w]++
+	}
+
+	c.contracted[v] = true
+	c.in[v], c.out[v] = nil, nil
+
+	return shortcuts
+}
+
+// witnessSearch runs a Dijkstra from the source in the remaining graph
+// without the excluded vertex, up to the maximum cost or the settle limit.
+func (c *contractor) witnessSearch(source, excluded int, maxCost float64) map[int]float64 {
+	dist := map[int]float64{source: 0}
+	settled := make(map[int]bool)
+	pq := &chQueue{{vertex: source, priority: 0}}
+
+	for pq.Len() > 0 && len(settled) < witnessSearchLimit {
+		item, _ := heap.Pop(pq).(chItem)
+		if settled[item.vertex] {
+			continue
+		}
+		settled[item.vertex] = true
+
+		if item.priority > maxCost {
+			break
+		}
+
+		for w, weight := range c.out[item.vertex] {
+			if w == excluded {
+				continue
+			}
+
+			d := item.priority + weight
+			if current, ok := dist[w]; !ok || d < current {
+				dist[w] = d
+				heap.Push(pq, chItem{vertex: w, priority: d})
+			}
+		}
+	}
+
+	return dist
+}
+
+type chItem struct {
+	vertex   int
+	priority float64
+}
+
+// chQueue is a min-heap of vertices by priority.
+type chQueue []chItem
+
+func (q chQueue) Len() int           { return len(q) }
+func (q chQueue) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q chQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *chQueue) Push(x interface{}) {
+	if item, ok := x.(chItem); ok {
+		*q = append(*q, item)
+	}
+}
+func (q *chQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
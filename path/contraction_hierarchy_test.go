@@ -0,0 +1,139 @@
+package path
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestContractionHierarchy_Grid(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	g := gograph.New[int](gograph.Weighted())
+
+	const size = 12
+	for i := 0; i < size*size; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			v := g.GetVertexByID(row*size + col)
+			if col+1 < size {
+				_, _ = g.AddEdge(v, g.GetVertexByID(row*size+col+1), gograph.WithEdgeWeight(float64(1+rng.Intn(9))))
+			}
+			if row+1 < size {
+				_, _ = g.AddEdge(v, g.GetVertexByID((row+1)*size+col), gograph.WithEdgeWeight(float64(1+rng.Intn(9))))
+			}
+		}
+	}
+
+	assertSameAsDijkstra(t, g)
+}
+
+func TestContractionHierarchy_RandomDirected(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	g := gograph.New[int](gograph.Weighted(), gograph.Directed())
+
+	const n = 80
+	for i := 0; i < n; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 0; i < 240; i++ {
+		from, to := rng.Intn(n), rng.Intn(n)
+		_, _ = g.AddEdge(g.GetVertexByID(from), g.GetVertexByID(to), gograph.WithEdgeWeight(float64(rng.Intn(10))))
+	}
+
+	assertSameAsDijkstra(t, g)
+}
+
+func TestContractionHierarchy_Errors(t *testing.T) {
+	if _, err := BuildContractionHierarchy(gograph.New[int]()); !errors.Is(err, ErrNotWeighted) {
+		t.Errorf("expected error %v, got %v", ErrNotWeighted, err)
+	}
+
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(-1))
+
+	if _, err := BuildContractionHierarchy(g); !errors.Is(err, ErrNegativeEdgeWeight) {
+		t.Errorf("expected error %v, got %v", ErrNegativeEdgeWeight, err)
+	}
+
+	g = gograph.New[string](gograph.Weighted(), gograph.Directed())
+	vA = g.AddVertexByLabel("A")
+	vB = g.AddVertexByLabel("B")
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(1))
+
+	ch, err := BuildContractionHierarchy(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, _, err = ch.Query("A", "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %v, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if _, _, err = ch.Query("B", "A"); !errors.Is(err, ErrNoPath) {
+		t.Errorf("expected error %v, got %v", ErrNoPath, err)
+	}
+
+	path, cost, err := ch.Query("A", "A")
+	if err != nil || cost != 0 || len(path) != 1 || path[0].Label() != "A" {
+		t.Errorf("expected path [A] with cost 0, got %v, %v, %v", labelsOf(path), cost, err)
+	}
+}
+
+// assertSameAsDijkstra compares the cost of the queries of every pair of
+// vertices with Dijkstra, and checks that the returned paths are valid.
+func assertSameAsDijkstra[T comparable](t *testing.T, g gograph.Graph[T]) {
+	t.Helper()
+
+	ch, err := BuildContractionHierarchy(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, from := range g.GetAllVertices() {
+		dist := Dijkstra(g, from.Label())
+
+		for _, to := range g.GetAllVertices() {
+			path, cost, err := ch.Query(from.Label(), to.Label())
+			if dist[to.Label()] == math.MaxFloat64 {
+				if !errors.Is(err, ErrNoPath) {
+					t.Errorf("%v -> %v: expected error %v, got %v", from.Label(), to.Label(), ErrNoPath, err)
+				}
+				continue
+			}
+
+			if err != nil {
+				t.Fatalf("%v -> %v: unexpected error: %v", from.Label(), to.Label(), err)
+			}
+
+			if cost != dist[to.Label()] {
+				t.Errorf("%v -> %v: expected cost %v, got %v", from.Label(), to.Label(), dist[to.Label()], cost)
+			}
+
+			if path[0].Label() != from.Label() || path[len(path)-1].Label() != to.Label() {
+				t.Fatalf("%v -> %v: invalid path ends %v", from.Label(), to.Label(), labelsOf(path))
+			}
+
+			var sum float64
+			for i := 1; i < len(path); i++ {
+				edge := g.GetEdge(path[i-1], path[i])
+				if edge == nil {
+					t.Fatalf("%v -> %v: missing edge %v -> %v", from.Label(), to.Label(), path[i-1].Label(), path[i].Label())
+				}
+				sum += edge.Weight()
+			}
+
+			if sum != cost {
+				t.Errorf("%v -> %v: expected path weight %v, got %v", from.Label(), to.Label(), cost, sum)
+			}
+		}
+	}
+}
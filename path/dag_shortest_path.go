@@ -0,0 +1,67 @@
+package path
+
+import (
+	"math"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// DAGShortestPath finds the shortest paths from the source vertex to all the
+// vertices of a weighted directed acyclic graph. It relaxes the outgoing
+// edges of the vertices in topological order, so each vertex is final when
+// it is reached, and negative weights are supported, since there are no
+// cycles.
+//
+// It returns the distances, where the unreachable vertices are at +Inf like
+// in BellmanFord, and the predecessor map, which maps each reachable vertex
+// except the source to the vertex it is reached from, and can be passed to
+// ReconstructPath.
+//
+// The time complexity is O(V+E), where V is the number of vertices and E is
+// the number of edges, which is faster than both Dijkstra and Bellman-Ford.
+//
+// It returns ErrNotWeighted if the graph is not weighted, ErrNotDirected if
+// it is not directed, gograph.ErrVertexDoesNotExist if the source vertex
+// doesn't exist, and ErrNotDAG, which is gograph.ErrDAGHasCycle, if the
+// graph contains a cycle.
+func DAGShortestPath[T comparable](g gograph.Graph[T], source T) (map[T]float64, map[T]T, error) {
+	if !g.IsWeighted() {
+		return nil, nil, ErrNotWeighted
+	}
+
+	if !g.IsDirected() {
+		return nil, nil, ErrNotDirected
+	}
+
+	if g.GetVertexByID(source) == nil {
+		return nil, nil, gograph.ErrVertexDoesNotExist
+	}
+
+	order, err := gograph.TopologySort(g)
+	if err != nil {
+		return nil, nil, ErrNotDAG
+	}
+
+	dist := make(map[T]float64, len(order))
+	for _, v := range order {
+		dist[v.Label()] = math.Inf(1)
+	}
+	dist[source] = 0
+
+	pred := make(map[T]T)
+	for _, v := range order {
+		if math.IsInf(dist[v.Label()], 1) {
+			continue
+		}
+
+		for _, neighbor := range v.Neighbors() {
+			d := dist[v.Label()] + g.GetEdge(v, neighbor).Weight()
+			if d < dist[neighbor.Label()] {
+				dist[neighbor.Label()] = d
+				pred[neighbor.Label()] = v.Label()
+			}
+		}
+	}
+
+	return dist, pred, nil
+}
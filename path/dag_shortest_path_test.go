@@ -0,0 +1,131 @@
+package path
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestDAGShortestPath(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+	for _, e := range []struct {
+		from, to string
+		weight   float64
+	}{
+		{"A", "B", 5}, {"A", "C", 3}, {"B", "D", -4}, {"C", "D", 2},
+		{"D", "E", 1}, {"C", "E", 7}, {"B", "F", -2}, {"E", "F", -3},
+	} {
+		_, _ = g.AddEdge(gograph.NewVertex(e.from), gograph.NewVertex(e.to), gograph.WithEdgeWeight(e.weight))
+	}
+	g.AddVertexByLabel("G")
+
+	dist, pred, err := DAGShortestPath(g, "A")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertSameAsBellmanFord(t, g, "A", dist)
+
+	path, ok := ReconstructPath(g, pred, "A", "F")
+	if !ok {
+		t.Fatal("expected a path from A to F")
+	}
+
+	expected := []string{"A", "B", "D", "E", "F"}
+	if labels := labelsOf(path); !reflect.DeepEqual(labels, expected) {
+		t.Errorf("expected path %v, got %v", expected, labels)
+	}
+
+	if _, ok = pred["G"]; ok {
+		t.Error("expected the unreachable vertex to have no predecessor")
+	}
+}
+
+func TestDAGShortestPathRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	g := gograph.New[int](gograph.Weighted(), gograph.Directed())
+
+	// the edges go from the lower labels to the higher ones, so it is a DAG
+	const n = 60
+	for i := 0; i < n; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 0; i < 200; i++ {
+		from, to := rng.Intn(n), rng.Intn(n)
+		if from == to {
+			continue
+		}
+		if from > to {
+			from, to = to, from
+		}
+
+		weight := float64(rng.Intn(21) - 10)
+		_, _ = g.AddEdge(g.GetVertexByID(from), g.GetVertexByID(to), gograph.WithEdgeWeight(weight))
+	}
+
+	for _, source := range []int{0, 5, 30} {
+		dist, pred, err := DAGShortestPath(g, source)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		assertSameAsBellmanFord(t, g, source, dist)
+
+		for to := range pred {
+			path, ok := ReconstructPath(g, pred, source, to)
+			if !ok {
+				t.Fatalf("expected a path from %d to %d", source, to)
+			}
+
+			var sum float64
+			for i := 1; i < len(path); i++ {
+				sum += g.GetEdge(path[i-1], path[i]).Weight()
+			}
+
+			if sum != dist[to] {
+				t.Errorf("expected the path from %d to %d to weigh %v, got %v", source, to, dist[to], sum)
+			}
+		}
+	}
+}
+
+func TestDAGShortestPathErrors(t *testing.T) {
+	if _, _, err := DAGShortestPath(gograph.New[string](gograph.Directed()), "A"); !errors.Is(err, ErrNotWeighted) {
+		t.Errorf("expected error %v, got %v", ErrNotWeighted, err)
+	}
+
+	if _, _, err := DAGShortestPath(gograph.New[string](gograph.Weighted()), "A"); !errors.Is(err, ErrNotDirected) {
+		t.Errorf("expected error %v, got %v", ErrNotDirected, err)
+	}
+
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"), gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(gograph.NewVertex("B"), gograph.NewVertex("A"), gograph.WithEdgeWeight(1))
+
+	if _, _, err := DAGShortestPath(g, "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %v, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if _, _, err := DAGShortestPath(g, "A"); !errors.Is(err, gograph.ErrDAGHasCycle) {
+		t.Errorf("expected error %v, got %v", gograph.ErrDAGHasCycle, err)
+	}
+}
+
+func assertSameAsBellmanFord[T comparable](t *testing.T, g gograph.Graph[T], source T, dist map[T]float64) {
+	t.Helper()
+
+	expected, err := BellmanFord(g, source)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for label, d := range expected {
+		if dist[label] != d {
+			t.Errorf("expected distance %v from %v to %v, got %v", d, source, label, dist[label])
+		}
+	}
+}
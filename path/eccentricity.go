@@ -0,0 +1,155 @@
+package path
+
+import (
+	"errors"
+	"math"
+
+	"github.com/gavinhailey/gograph"
+)
+
+var (
+	// ErrGraphDisconnected is returned when a function requires every vertex
+	// to be reachable from every other vertex, but the graph is disconnected.
+	ErrGraphDisconnected = errors.New("graph is disconnected")
+)
+
+// WeightedEccentricity returns the eccentricity of the vertex with the
+// specified label in a weighted graph, which is the greatest shortest-path
+// distance from the vertex to any other vertex of the graph. Distances are
+// computed by Dijkstra's algorithm, so the edge weights must not be negative.
+//
+// It returns ErrNotWeighted if the graph is not weighted, ErrVertexDoesNotExist
+// if the vertex doesn't exist, and ErrGraphDisconnected if any vertex is not
+// reachable from the specified vertex.
+func WeightedEccentricity[T comparable](g gograph.Graph[T], label T) (float64, error) {
+	if !g.IsWeighted() {
+		return 0, ErrNotWeighted
+	}
+
+	if g.GetVertexByID(label) == nil {
+		return 0, gograph.ErrVertexDoesNotExist
+	}
+
+	return weightedEccentricity(g, label)
+}
+
+// WeightedRadius returns the radius of a weighted graph, which is the
+// minimum eccentricity of its vertices. It runs Dijkstra's algorithm from
+// each vertex, so the time complexity is O(V(E+V)logV).
+//
+// The radius of an empty graph is zero.
+//
+// It returns ErrNotWeighted if the graph is not weighted, and
+// ErrGraphDisconnected if the graph is disconnected.
+func WeightedRadius[T comparable](g gograph.Graph[T]) (float64, error) {
+	if !g.IsWeighted() {
+		return 0, ErrNotWeighted
+	}
+
+	vertices := g.GetAllVertices()
+	if len(vertices) == 0 {
+		return 0, nil
+	}
+
+	radius := math.Inf(1)
+	for _, v := range vertices {
+		eccentricity, err := weightedEccentricity(g, v.Label())
+		if err != nil {
+			return 0, err
+		}
+
+		radius = math.Min(radius, eccentricity)
+	}
+
+	return radius, nil
+}
+
+func weightedEccentricity[T comparable](g gograph.Graph[T], label T) (float64, error) {
+	var eccentricity float64
+	for _, dist := range Dijkstra(g, label) {
+		if dist == math.MaxFloat64 {
+			return 0, ErrGraphDisconnected
+		}
+
+		eccentricity = math.Max(eccentricity, dist)
+	}
+
+	return eccentricity, nil
+}
+
+// Center returns the vertices with the minimum eccentricity in an unweighted
+// graph, where the eccentricity of a vertex is the greatest number of edges
+// in a shortest path from the vertex to any other vertex.
+//
+// It returns ErrGraphDisconnected if the graph is disconnected.
+func Center[T comparable](g gograph.Graph[T]) ([]*gograph.Vertex[T], error) {
+	return selectByEccentricity(g, func(a, b int) bool { return a < b })
+}
+
+// Periphery returns the vertices with the maximum eccentricity in an
+// unweighted graph, where the eccentricity of a vertex is the greatest
+// number of edges in a shortest path from the vertex to any other vertex.
+//
+// It returns ErrGraphDisconnected if the graph is disconnected.
+func Periphery[T comparable](g gograph.Graph[T]) ([]*gograph.Vertex[T], error) {
+	return selectByEccentricity(g, func(a, b int) bool { return a > b })
+}
+
+// selectByEccentricity computes the eccentricity of all vertices by running
+// a BFS from each of them once, and returns the vertices whose eccentricity
+// is the best according to the 'better' function.
+func selectByEccentricity[T comparable](
+	g gograph.Graph[T],
+	better func(a, b int) bool,
+) ([]*gograph.Vertex[T], error) {
+	vertices := g.GetAllVertices()
+
+	var (
+		best     int
+		selected []*gograph.Vertex[T]
+	)
+	for _, v := range vertices {
+		eccentricity, err := eccentricity(g, v.Label(), len(vertices))
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case len(selected) == 0 || better(eccentricity, best):
+			best = eccentricity
+			selected = []*gograph.Vertex[T]{v}
+		case eccentricity == best:
+			selected = append(selected, v)
+		}
+	}
+
+	return selected, nil
+}
+
+// eccentricity returns the unweighted eccentricity of the vertex with the
+// specified label using a breadth-first search. The order is the number of
+// vertices in the graph, and it is used to detect unreachable vertices.
+func eccentricity[T comparable](g gograph.Graph[T], label T, order int) (int, error) {
+	depth := map[T]int{label: 0}
+	queue := []*gograph.Vertex[T]{g.GetVertexByID(label)}
+
+	var eccentricity int
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+
+		eccentricity = max(eccentricity, depth[curr.Label()])
+		for _, neighbor := range curr.Neighbors() {
+			if _, ok := depth[neighbor.Label()]; !ok {
+				depth[neighbor.Label()] = depth[curr.Label()] + 1
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	if len(depth) != order {
+		return 0, ErrGraphDisconnected
+	}
+
+	return eccentricity, nil
+}
@@ -0,0 +1,122 @@
+package path
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestWeightedEccentricity(t *testing.T) {
+	// A -1- B -2- C -4- D
+	//        \         /
+	//         ---5----
+	g := gograph.New[string](gograph.Weighted())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vC, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vC, vD, gograph.WithEdgeWeight(4))
+	_, _ = g.AddEdge(vB, vD, gograph.WithEdgeWeight(5))
+
+	expected := map[string]float64{"A": 6, "B": 5, "C": 4, "D": 6}
+	for label, want := range expected {
+		got, err := WeightedEccentricity(g, label)
+		if err != nil {
+			t.Errorf("Expected no errors, but get an err: %s", err)
+		}
+
+		if got != want {
+			t.Errorf("Expected eccentricity of %s to be %f, got %f", label, want, got)
+		}
+	}
+
+	radius, err := WeightedRadius(g)
+	if err != nil {
+		t.Errorf("Expected no errors, but get an err: %s", err)
+	}
+
+	if radius != 4 {
+		t.Errorf("Expected radius to be 4, got %f", radius)
+	}
+
+	_, err = WeightedEccentricity(g, "X")
+	if !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error \"%s\", but got \"%v\"", gograph.ErrVertexDoesNotExist, err)
+	}
+}
+
+func TestWeightedRadius_Disconnected(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted())
+
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"), gograph.WithEdgeWeight(1))
+	g.AddVertexByLabel("C")
+
+	_, err := WeightedRadius(g)
+	if !errors.Is(err, ErrGraphDisconnected) {
+		t.Errorf("Expected error \"%s\", but got \"%v\"", ErrGraphDisconnected, err)
+	}
+
+	_, err = WeightedEccentricity(g, "A")
+	if !errors.Is(err, ErrGraphDisconnected) {
+		t.Errorf("Expected error \"%s\", but got \"%v\"", ErrGraphDisconnected, err)
+	}
+}
+
+func TestWeightedRadius_NotWeighted(t *testing.T) {
+	g := gograph.New[string]()
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"))
+
+	_, err := WeightedRadius(g)
+	if !errors.Is(err, ErrNotWeighted) {
+		t.Errorf("Expected error \"%s\", but got \"%v\"", ErrNotWeighted, err)
+	}
+}
+
+func TestCenterAndPeriphery(t *testing.T) {
+	// 1 - 2 - 3 - 4 - 5
+	g := gograph.New[int]()
+	for i := 1; i < 5; i++ {
+		_, _ = g.AddEdge(gograph.NewVertex(i), gograph.NewVertex(i+1))
+	}
+
+	center, err := Center(g)
+	if err != nil {
+		t.Errorf("Expected no errors, but get an err: %s", err)
+	}
+
+	if len(center) != 1 || center[0].Label() != 3 {
+		t.Errorf("Expected center to be [3], got %v", labelsOf(center))
+	}
+
+	periphery, err := Periphery(g)
+	if err != nil {
+		t.Errorf("Expected no errors, but get an err: %s", err)
+	}
+
+	labels := labelsOf(periphery)
+	sort.Ints(labels)
+	if !reflect.DeepEqual(labels, []int{1, 5}) {
+		t.Errorf("Expected periphery to be [1 5], got %v", labels)
+	}
+
+	g.AddVertexByLabel(6)
+	_, err = Center(g)
+	if !errors.Is(err, ErrGraphDisconnected) {
+		t.Errorf("Expected error \"%s\", but got \"%v\"", ErrGraphDisconnected, err)
+	}
+}
+
+func labelsOf[T comparable](vertices []*gograph.Vertex[T]) []T {
+	labels := make([]T, len(vertices))
+	for i := range vertices {
+		labels[i] = vertices[i].Label()
+	}
+	return labels
+}
@@ -0,0 +1,135 @@
+package path
+
+import (
+	"math"
+
+	"github.com/gavinhailey/gograph"
+	"github.com/gavinhailey/gograph/util"
+)
+
+// Johnson finds the shortest paths between all pairs of vertices in a
+// weighted directed graph, even in the presence of negative weight edges
+// (as long as there are no negative weight cycles). It was proposed by
+// Donald B. Johnson.
+//
+// Steps:
+//
+//  1. Reweighting: Run Bellman-Ford from a virtual vertex connected to every
+//     vertex by a zero weight edge, to compute a potential h(v) for each
+//     vertex. Every edge u->v is then reweighted to w(u, v) + h(u) - h(v),
+//     which is never negative, and preserves the shortest paths. If
+//     Bellman-Ford finds a negative weight cycle, the algorithm stops.
+//
+//  2. Shortest Path Calculation: Run Dijkstra from each vertex on the
+//     reweighted graph.
+//
+//  3. Output: Undo the reweighting of each distance, d(u, v) = d'(u, v) -
+//     h(u) + h(v).
+//
+// The time complexity of Johnson's algorithm is O(V*E*logV), which is faster
+// than FloydWarshall on sparse graphs. The result has the same shape as
+// the result of FloydWarshall: the distance of a vertex to itself is 0, and
+// the distance to an unreachable vertex is positive infinity.
+//
+// It returns ErrNotWeighted if the graph is not weighted, ErrNotDirected if the
+// graph is not directed, and ErrNegativeWeightCycle if the graph contains a
+// negative weight cycle.
+func Johnson[T comparable](g gograph.Graph[T]) (map[T]map[T]float64, error) {
+	if !g.IsWeighted() {
+		return nil, ErrNotWeighted
+	}
+
+	if !g.IsDirected() {
+		return nil, ErrNotDirected
+	}
+
+	vertices := g.GetAllVertices()
+	edges := g.AllEdges()
+
+	// Bellman-Ford from the virtual vertex, which reaches every vertex
+	// with a zero weight edge, so all potentials start at 0.
+	potential := make(map[T]float64, len(vertices))
+	for _, v := range vertices {
+		potential[v.Label()] = 0
+	}
+
+	for i := 0; i < len(vertices); i++ {
+		var updated bool
+		for _, edge := range edges {
+			from, to := edge.Source().Label(), edge.Destination().Label()
+			if potential[from]+edge.Weight() < potential[to] {
+				potential[to] = potential[from] + edge.Weight()
+				updated = true
+			}
+		}
+
+		if !updated {
+			break
+		}
+
+		if i == len(vertices)-1 {
+			return nil, ErrNegativeWeightCycle
+		}
+	}
+
+	adjacency := make(map[T][]*gograph.Edge[T], len(vertices))
+	for _, edge := range edges {
+		adjacency[edge.Source().Label()] = append(adjacency[edge.Source().Label()], edge)
+	}
+
+	dist := make(map[T]map[T]float64, len(vertices))
+	for _, source := range vertices {
+		reweighted := johnsonDijkstra(source, adjacency, potential)
+
+		destMap := make(map[T]float64, len(vertices))
+		for _, dest := range vertices {
+			destMap[dest.Label()] = math.Inf(1)
+			if d, ok := reweighted[dest.Label()]; ok {
+				destMap[dest.Label()] = d - potential[source.Label()] + potential[dest.Label()]
+			}
+		}
+
+		dist[source.Label()] = destMap
+	}
+
+	return dist, nil
+}
+
+// johnsonDijkstra returns the reweighted distances from the source to all
+// reachable vertices. Unreachable vertices are omitted.
+func johnsonDijkstra[T comparable](
+	source *gograph.Vertex[T],
+	adjacency map[T][]*gograph.Edge[T],
+	potential map[T]float64,
+) map[T]float64 {
+	dist := map[T]float64{source.Label(): 0}
+	visited := make(map[T]bool)
+
+	pq := util.NewVertexPriorityQueue[T]()
+	pq.Push(util.NewVertexWithPriority(source, 0))
+
+	for pq.Len() > 0 {
+		curr := pq.Pop()
+		label := curr.Vertex().Label()
+		if visited[label] {
+			continue
+		}
+		visited[label] = true
+
+		for _, edge := range adjacency[label] {
+			dest := edge.Destination()
+			if visited[dest.Label()] {
+				continue
+			}
+
+			// the reweighted edge is never negative, except for rounding errors
+			weight := max(edge.Weight()+potential[label]-potential[dest.Label()], 0)
+			if d, ok := dist[dest.Label()]; !ok || curr.Priority()+weight < d {
+				dist[dest.Label()] = curr.Priority() + weight
+				pq.Push(util.NewVertexWithPriority(dest, dist[dest.Label()]))
+			}
+		}
+	}
+
+	return dist
+}
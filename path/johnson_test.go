@@ -0,0 +1,98 @@
+package path
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestJohnson(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	vE := g.AddVertexByLabel("E")
+	vF := g.AddVertexByLabel("F")
+
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(5))
+	_, _ = g.AddEdge(vB, vC, gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vD, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vC, vE, gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vE, vD, gograph.WithEdgeWeight(-1))
+	_, _ = g.AddEdge(vD, vF, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vF, vE, gograph.WithEdgeWeight(3))
+	_, _ = g.AddEdge(vF, vB, gograph.WithEdgeWeight(-3))
+
+	assertSameAsFloydWarshall(t, g)
+}
+
+func TestJohnsonRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	g := gograph.New[int](gograph.Weighted(), gograph.Directed())
+
+	// potentials make every cycle non-negative, while edges still can be negative
+	const n = 30
+	potential := make([]float64, n)
+	for i := range potential {
+		potential[i] = float64(rng.Intn(20))
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 0; i < 120; i++ {
+		from, to := rng.Intn(n), rng.Intn(n)
+		if from == to {
+			continue
+		}
+
+		weight := float64(rng.Intn(10)) + potential[to] - potential[from]
+		_, _ = g.AddEdge(g.GetVertexByID(from), g.GetVertexByID(to), gograph.WithEdgeWeight(weight))
+	}
+
+	assertSameAsFloydWarshall(t, g)
+}
+
+func assertSameAsFloydWarshall[T comparable](t *testing.T, g gograph.Graph[T]) {
+	t.Helper()
+
+	dist, err := Johnson(g)
+	if err != nil {
+		t.Fatalf("Expected no errors, but get an err: %s", err)
+	}
+
+	expected, err := FloydWarshall(g)
+	if err != nil {
+		t.Fatalf("Expected no errors, but get an err: %s", err)
+	}
+
+	for source, destMap := range expected {
+		for dest, d := range destMap {
+			if got := dist[source][dest]; got != d && math.Abs(got-d) > 1e-9 {
+				t.Errorf("Expected distance from %v to %v to be %v, got %v", source, dest, d, got)
+			}
+		}
+	}
+}
+
+func TestJohnsonErrors(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"), gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(gograph.NewVertex("B"), gograph.NewVertex("C"), gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(gograph.NewVertex("C"), gograph.NewVertex("A"), gograph.WithEdgeWeight(-3))
+
+	if _, err := Johnson(g); !errors.Is(err, ErrNegativeWeightCycle) {
+		t.Errorf("Expected error %v, got %v", ErrNegativeWeightCycle, err)
+	}
+
+	if _, err := Johnson(gograph.New[string](gograph.Directed())); !errors.Is(err, ErrNotWeighted) {
+		t.Errorf("Expected error %v, got %v", ErrNotWeighted, err)
+	}
+
+	if _, err := Johnson(gograph.New[string](gograph.Weighted())); !errors.Is(err, ErrNotDirected) {
+		t.Errorf("Expected error %v, got %v", ErrNotDirected, err)
+	}
+}
@@ -0,0 +1,93 @@
+package path
+
+import (
+	"github.com/gavinhailey/gograph"
+	"github.com/gavinhailey/gograph/util"
+)
+
+// ShortestPathsToTargets finds the shortest paths from the source vertex to
+// each of the target vertices with a single run of Dijkstra's algorithm,
+// which stops as soon as all the targets are settled, instead of running a
+// separate search for every target. The edge weights must not be negative.
+//
+// It returns the vertices of the path to each target, including both
+// endpoints, and its cost. The targets that are not reachable from the
+// source are absent from both maps.
+//
+// It returns ErrNotWeighted if the graph is not weighted,
+// ErrVertexDoesNotExist if the source or any of the targets doesn't exist,
+// and ErrNegativeEdgeWeight if the search reaches an edge with a negative
+// weight.
+func ShortestPathsToTargets[T comparable](
+	g gograph.Graph[T],
+	source T,
+	targets []T,
+) (map[T][]*gograph.Vertex[T], map[T]float64, error) {
+	if !g.IsWeighted() {
+		return nil, nil, ErrNotWeighted
+	}
+
+	start := g.GetVertexByID(source)
+	if start == nil {
+		return nil, nil, gograph.ErrVertexDoesNotExist
+	}
+
+	pending := make(map[T]bool, len(targets))
+	for _, target := range targets {
+		if g.GetVertexByID(target) == nil {
+			return nil, nil, gograph.ErrVertexDoesNotExist
+		}
+		pending[target] = true
+	}
+
+	dist := map[T]float64{source: 0}
+	prev := make(map[T]T)
+	visited := make(map[T]bool)
+
+	pq := util.NewVertexPriorityQueue[T]()
+	pq.Push(util.NewVertexWithPriority(start, 0))
+	for pq.Len() > 0 && len(pending) > 0 {
+		curr := pq.Pop().Vertex()
+		if visited[curr.Label()] {
+			continue
+		}
+		visited[curr.Label()] = true
+		delete(pending, curr.Label())
+
+		if len(pending) == 0 {
+			break
+		}
+
+		for _, neighbor := range curr.Neighbors() {
+			label := neighbor.Label()
+			if visited[label] {
+				continue
+			}
+
+			weight := g.GetEdge(curr, neighbor).Weight()
+			if weight < 0 {
+				return nil, nil, ErrNegativeEdgeWeight
+			}
+
+			cost := dist[curr.Label()] + weight
+			if d, ok := dist[label]; !ok || cost < d {
+				dist[label] = cost
+				prev[label] = curr.Label()
+				pq.Push(util.NewVertexWithPriority(neighbor, cost))
+			}
+		}
+	}
+
+	paths := make(map[T][]*gograph.Vertex[T])
+	costs := make(map[T]float64)
+	for _, target := range targets {
+		if !visited[target] {
+			continue
+		}
+
+		paths[target], _ = ReconstructPath(g, prev, source, target)
+		costs[target] = dist[target]
+	}
+
+	return paths, costs, nil
+}
@@ -0,0 +1,124 @@
+package path
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// edgeCountingGraph counts the edges looked up by a search, to tell how much
+// of the graph it explored.
+type edgeCountingGraph[T comparable] struct {
+	gograph.Graph[T]
+	lookups int
+}
+
+func (g *edgeCountingGraph[T]) GetEdge(from, to *gograph.Vertex[T]) *gograph.Edge[T] {
+	g.lookups++
+	return g.Graph.GetEdge(from, to)
+}
+
+func TestShortestPathsToTargets(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	g := gograph.New[int](gograph.Weighted(), gograph.Directed())
+
+	const n = 60
+	for i := 0; i < n; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 0; i < 180; i++ {
+		from, to := rng.Intn(n), rng.Intn(n)
+		_, _ = g.AddEdge(g.GetVertexByID(from), g.GetVertexByID(to), gograph.WithEdgeWeight(float64(rng.Intn(10))))
+	}
+
+	for source := 0; source < n; source += 7 {
+		targets := []int{source, rng.Intn(n), rng.Intn(n), rng.Intn(n)}
+		paths, costs, err := ShortestPathsToTargets[int](g, source, targets)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		dist := Dijkstra[int](g, source)
+		for _, target := range targets {
+			if dist[target] == math.MaxFloat64 {
+				if _, ok := costs[target]; ok {
+					t.Errorf("%d -> %d: expected unreachable, got cost %v", source, target, costs[target])
+				}
+				if _, ok := paths[target]; ok {
+					t.Errorf("%d -> %d: expected no path, got %v", source, target, labelsOf(paths[target]))
+				}
+				continue
+			}
+
+			if costs[target] != dist[target] {
+				t.Errorf("%d -> %d: expected cost %v, got %v", source, target, dist[target], costs[target])
+			}
+
+			path := paths[target]
+			if len(path) == 0 || path[0].Label() != source || path[len(path)-1].Label() != target {
+				t.Fatalf("%d -> %d: invalid path %v", source, target, labelsOf(path))
+			}
+
+			var cost float64
+			for i := 1; i < len(path); i++ {
+				cost += g.GetEdge(path[i-1], path[i]).Weight()
+			}
+			if cost != costs[target] {
+				t.Errorf("%d -> %d: expected path cost %v, got %v", source, target, costs[target], cost)
+			}
+		}
+	}
+}
+
+func TestShortestPathsToTargets_StopsEarly(t *testing.T) {
+	g := gograph.New[int](gograph.Weighted(), gograph.Directed())
+
+	// the targets are next to the source, and a long chain hangs off it
+	for i := 1; i <= 3; i++ {
+		_, _ = g.AddEdge(gograph.NewVertex(0), gograph.NewVertex(i), gograph.WithEdgeWeight(1))
+	}
+	_, _ = g.AddEdge(gograph.NewVertex(0), gograph.NewVertex(100), gograph.WithEdgeWeight(5))
+	for i := 100; i < 200; i++ {
+		_, _ = g.AddEdge(gograph.NewVertex(i), gograph.NewVertex(i+1), gograph.WithEdgeWeight(1))
+	}
+
+	counting := &edgeCountingGraph[int]{Graph: g}
+	_, costs, err := ShortestPathsToTargets[int](counting, 0, []int{1, 2, 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(costs) != 3 {
+		t.Errorf("expected 3 costs, got %v", costs)
+	}
+
+	// only the edges of the source are looked up
+	if counting.lookups != 4 {
+		t.Errorf("expected 4 edge lookups, got %d", counting.lookups)
+	}
+}
+
+func TestShortestPathsToTargets_Errors(t *testing.T) {
+	if _, _, err := ShortestPathsToTargets(gograph.New[int](), 0, nil); !errors.Is(err, ErrNotWeighted) {
+		t.Errorf("expected error %v, got %v", ErrNotWeighted, err)
+	}
+
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"), gograph.WithEdgeWeight(-1))
+
+	if _, _, err := ShortestPathsToTargets(g, "X", []string{"A"}); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %v, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if _, _, err := ShortestPathsToTargets(g, "A", []string{"X"}); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %v, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if _, _, err := ShortestPathsToTargets(g, "A", []string{"B"}); !errors.Is(err, ErrNegativeEdgeWeight) {
+		t.Errorf("expected error %v, got %v", ErrNegativeEdgeWeight, err)
+	}
+}
@@ -0,0 +1,47 @@
+package path
+
+import (
+	"math"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// PairwiseDistances finds the shortest distances between all pairs of the
+// specified vertices, running Dijkstra's algorithm from each of them with
+// ShortestPathsToTargets, so each search stops as soon as it reaches all the
+// other vertices of the subset. For a subset of k vertices, it is much
+// cheaper than the full all-pairs matrix of FloydWarshall or Johnson when k
+// is small relative to the graph. The edge weights must not be negative.
+//
+// The result has the same shape as the result of FloydWarshall restricted
+// to the subset: the distance of a vertex to itself is 0, and the distance
+// to an unreachable vertex is positive infinity.
+//
+// It returns ErrNotWeighted if the graph is not weighted,
+// ErrVertexDoesNotExist if any of the labels doesn't exist, and
+// ErrNegativeEdgeWeight if a search reaches an edge with a negative weight.
+func PairwiseDistances[T comparable](g gograph.Graph[T], labels []T) (map[T]map[T]float64, error) {
+	dist := make(map[T]map[T]float64, len(labels))
+	for _, source := range labels {
+		if _, ok := dist[source]; ok {
+			continue
+		}
+
+		_, costs, err := ShortestPathsToTargets(g, source, labels)
+		if err != nil {
+			return nil, err
+		}
+
+		row := make(map[T]float64, len(labels))
+		for _, dest := range labels {
+			row[dest] = math.Inf(1)
+			if cost, ok := costs[dest]; ok {
+				row[dest] = cost
+			}
+		}
+
+		dist[source] = row
+	}
+
+	return dist, nil
+}
@@ -0,0 +1,74 @@
+package path
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestPairwiseDistances(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	g := gograph.New[int](gograph.Weighted(), gograph.Directed())
+
+	const n = 50
+	for i := 0; i < n; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 0; i < 120; i++ {
+		from, to := rng.Intn(n), rng.Intn(n)
+		_, _ = g.AddEdge(g.GetVertexByID(from), g.GetVertexByID(to), gograph.WithEdgeWeight(float64(rng.Intn(10))))
+	}
+
+	labels := []int{3, 17, 17, 25, 31, 42, 49}
+	dist, err := PairwiseDistances[int](g, labels)
+	if err != nil {
+		t.Fatalf("Expected no errors, but get an err: %s", err)
+	}
+
+	expected, err := FloydWarshall[int](g)
+	if err != nil {
+		t.Fatalf("Expected no errors, but get an err: %s", err)
+	}
+
+	if len(dist) != len(labels)-1 {
+		t.Errorf("Expected %d rows, got %d", len(labels)-1, len(dist))
+	}
+
+	for _, source := range labels {
+		if len(dist[source]) != len(labels)-1 {
+			t.Errorf("Expected %d columns from %d, got %d", len(labels)-1, source, len(dist[source]))
+		}
+
+		for _, dest := range labels {
+			if got, d := dist[source][dest], expected[source][dest]; got != d {
+				t.Errorf("Expected distance from %v to %v to be %v, got %v", source, dest, d, got)
+			}
+		}
+	}
+}
+
+func TestPairwiseDistancesErrors(t *testing.T) {
+	if _, err := PairwiseDistances(gograph.New[int](), []int{1}); !errors.Is(err, ErrNotWeighted) {
+		t.Errorf("Expected error %v, got %v", ErrNotWeighted, err)
+	}
+
+	g := gograph.New[string](gograph.Weighted())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"), gograph.WithEdgeWeight(1))
+
+	if _, err := PairwiseDistances(g, []string{"A", "X"}); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %v, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	// undirected graphs are supported
+	dist, err := PairwiseDistances(g, []string{"A", "B"})
+	if err != nil {
+		t.Fatalf("Expected no errors, but get an err: %s", err)
+	}
+
+	if dist["A"]["B"] != 1 || dist["B"]["A"] != 1 || dist["A"]["A"] != 0 {
+		t.Errorf("Expected symmetric distances, got %v", dist)
+	}
+}
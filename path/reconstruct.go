@@ -0,0 +1,43 @@
+package path
+
+import "github.com/gavinhailey/gograph"
+
+// ReconstructPath walks the predecessor map produced by a shortest path
+// search, such as Dijkstra or BFS, back from the 'to' vertex to the 'from'
+// vertex, and returns the vertices of the path from 'from' to 'to'. The
+// predecessor map maps the label of each reached vertex to the label of the
+// vertex it was reached from. The path of a vertex to itself is the vertex
+// alone.
+//
+// It returns false if the 'to' vertex is not reachable through the map, or
+// if any of the labels doesn't exist in the graph. The walk takes at most
+// as many steps as the size of the map, so a malformed map with a cycle
+// returns false instead of looping forever.
+func ReconstructPath[T comparable](g gograph.Graph[T], pred map[T]T, from, to T) ([]*gograph.Vertex[T], bool) {
+	var reversed []*gograph.Vertex[T]
+	label := to
+	for steps := 0; steps <= len(pred); steps++ {
+		v := g.GetVertexByID(label)
+		if v == nil {
+			return nil, false
+		}
+
+		reversed = append(reversed, v)
+		if label == from {
+			path := make([]*gograph.Vertex[T], len(reversed))
+			for i, v := range reversed {
+				path[len(reversed)-1-i] = v
+			}
+
+			return path, true
+		}
+
+		prev, ok := pred[label]
+		if !ok {
+			return nil, false
+		}
+		label = prev
+	}
+
+	return nil, false
+}
@@ -0,0 +1,50 @@
+package path
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestReconstructPath(t *testing.T) {
+	g := gograph.New[string](gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"))
+	_, _ = g.AddEdge(gograph.NewVertex("B"), gograph.NewVertex("C"))
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("D"))
+	g.AddVertexByLabel("E")
+
+	pred := map[string]string{"B": "A", "C": "B", "D": "A"}
+
+	tests := []struct {
+		name     string
+		pred     map[string]string
+		from, to string
+		expected []string
+		ok       bool
+	}{
+		{name: "path", pred: pred, from: "A", to: "C", expected: []string{"A", "B", "C"}, ok: true},
+		{name: "same vertex", pred: pred, from: "A", to: "A", expected: []string{"A"}, ok: true},
+		{name: "unreachable", pred: pred, from: "A", to: "E", ok: false},
+		{name: "other source", pred: pred, from: "D", to: "C", ok: false},
+		{name: "unknown vertex", pred: map[string]string{"X": "A"}, from: "A", to: "X", ok: false},
+		{name: "cycle", pred: map[string]string{"B": "C", "C": "B"}, from: "A", to: "C", ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path, ok := ReconstructPath(g, test.pred, test.from, test.to)
+			if ok != test.ok {
+				t.Fatalf("Expected ok to be %v, got %v", test.ok, ok)
+			}
+
+			if ok && !reflect.DeepEqual(labelsOf(path), test.expected) {
+				t.Errorf("Expected path %v, got %v", test.expected, labelsOf(path))
+			}
+
+			if !ok && path != nil {
+				t.Errorf("Expected nil path, got %v", labelsOf(path))
+			}
+		})
+	}
+}
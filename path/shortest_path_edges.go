@@ -0,0 +1,103 @@
+package path
+
+import (
+	"github.com/gavinhailey/gograph"
+	"github.com/gavinhailey/gograph/util"
+)
+
+// ShortestPathEdges finds the cheapest path from the 'from' vertex to the
+// 'to' vertex in a weighted graph with Dijkstra's algorithm, and returns the
+// edges of the path in order, along with its cost. Unlike the functions that
+// return the vertices of a path, it tells exactly which edge was taken: if
+// multiple edges connect two vertices, as in a multigraph, it picks the one
+// with the minimum weight. The path of a vertex to itself has no edges.
+//
+// It returns ErrNotWeighted if the graph is not weighted,
+// ErrVertexDoesNotExist if any of the vertices doesn't exist,
+// ErrNegativeEdgeWeight if the search reaches an edge with a negative
+// weight, and ErrNoPath if the 'to' vertex is not reachable from the 'from'
+// vertex.
+func ShortestPathEdges[T comparable](g gograph.Graph[T], from, to T) ([]*gograph.Edge[T], float64, error) {
+	if !g.IsWeighted() {
+		return nil, 0, ErrNotWeighted
+	}
+
+	source := g.GetVertexByID(from)
+	if source == nil || g.GetVertexByID(to) == nil {
+		return nil, 0, gograph.ErrVertexDoesNotExist
+	}
+
+	dist := map[T]float64{from: 0}
+	prev := make(map[T]*gograph.Edge[T])
+	visited := make(map[T]bool)
+
+	pq := util.NewVertexPriorityQueue[T]()
+	pq.Push(util.NewVertexWithPriority(source, 0))
+	for pq.Len() > 0 {
+		curr := pq.Pop().Vertex()
+		if visited[curr.Label()] {
+			continue
+		}
+		visited[curr.Label()] = true
+
+		if curr.Label() == to {
+			break
+		}
+
+		for _, neighbor := range curr.Neighbors() {
+			label := neighbor.Label()
+			if visited[label] {
+				continue
+			}
+
+			edge := cheapestEdge(g, curr, neighbor)
+			if edge == nil {
+				continue
+			}
+
+			if edge.Weight() < 0 {
+				return nil, 0, ErrNegativeEdgeWeight
+			}
+
+			cost := dist[curr.Label()] + edge.Weight()
+			if d, ok := dist[label]; !ok || cost < d {
+				dist[label] = cost
+				prev[label] = edge
+				pq.Push(util.NewVertexWithPriority(g.GetVertexByID(label), cost))
+			}
+		}
+	}
+
+	if !visited[to] {
+		return nil, 0, ErrNoPath
+	}
+
+	var edges []*gograph.Edge[T]
+	for label := to; label != from; label = prev[label].Source().Label() {
+		edges = append(edges, prev[label])
+	}
+
+	for i, j := 0, len(edges)-1; i < j; i, j = i+1, j-1 {
+		edges[i], edges[j] = edges[j], edges[i]
+	}
+
+	return edges, dist[to], nil
+}
+
+// cheapestEdge returns the edge with the minimum weight among the edges going
+// from the 'from' vertex to the 'to' vertex, or nil if there is none.
+func cheapestEdge[T comparable](g gograph.Graph[T], from, to *gograph.Vertex[T]) *gograph.Edge[T] {
+	var cheapest *gograph.Edge[T]
+	for _, edge := range g.GetAllEdges(from, to) {
+		// in undirected graph, the edges of the other direction are included
+		if edge.Source().Label() != from.Label() {
+			continue
+		}
+
+		if cheapest == nil || edge.Weight() < cheapest.Weight() {
+			cheapest = edge
+		}
+	}
+
+	return cheapest
+}
@@ -0,0 +1,106 @@
+package path
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// multiGraph adds parallel edges to a graph, which doesn't support them, to
+// simulate a multigraph.
+type multiGraph[T comparable] struct {
+	gograph.Graph[T]
+	parallel map[[2]T][]*gograph.Edge[T]
+}
+
+func (g *multiGraph[T]) addParallelEdge(from, to T, weight float64) *gograph.Edge[T] {
+	edge := gograph.NewEdge(g.GetVertexByID(from), g.GetVertexByID(to), gograph.WithEdgeWeight(weight))
+	g.parallel[[2]T{from, to}] = append(g.parallel[[2]T{from, to}], edge)
+
+	return edge
+}
+
+func (g *multiGraph[T]) GetAllEdges(from, to *gograph.Vertex[T]) []*gograph.Edge[T] {
+	return append(g.Graph.GetAllEdges(from, to), g.parallel[[2]T{from.Label(), to.Label()}]...)
+}
+
+func TestShortestPathEdges(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"), gograph.WithEdgeWeight(5))
+	_, _ = g.AddEdge(gograph.NewVertex("B"), gograph.NewVertex("C"), gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("C"), gograph.WithEdgeWeight(5))
+
+	edges, cost, err := ShortestPathEdges[string](g, "A", "C")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cost != 5 || len(edges) != 1 || edges[0] != g.GetEdge(g.GetVertexByID("A"), g.GetVertexByID("C")) {
+		t.Errorf("expected the direct edge with cost 5, got %v with cost %v", edges, cost)
+	}
+
+	// the cheaper parallel edge from A to B makes the path through B cheaper
+	multi := &multiGraph[string]{Graph: g, parallel: make(map[[2]string][]*gograph.Edge[string])}
+	multi.addParallelEdge("A", "B", 7)
+	cheap := multi.addParallelEdge("A", "B", 2)
+
+	edges, cost, err = ShortestPathEdges[string](multi, "A", "C")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cost != 3 || len(edges) != 2 {
+		t.Fatalf("expected 2 edges with cost 3, got %v with cost %v", edges, cost)
+	}
+
+	if edges[0] != cheap || edges[1] != g.GetEdge(g.GetVertexByID("B"), g.GetVertexByID("C")) {
+		t.Errorf("expected the cheaper parallel edge, got %v", edges)
+	}
+
+	// the path of a vertex to itself has no edges
+	edges, cost, err = ShortestPathEdges[string](g, "A", "A")
+	if err != nil || len(edges) != 0 || cost != 0 {
+		t.Errorf("expected an empty path, got %v with cost %v and error %v", edges, cost, err)
+	}
+}
+
+func TestShortestPathEdgesUndirected(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"), gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(gograph.NewVertex("C"), gograph.NewVertex("B"), gograph.WithEdgeWeight(2))
+
+	edges, cost, err := ShortestPathEdges[string](g, "C", "A")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the edges are oriented along the path
+	if cost != 3 || len(edges) != 2 ||
+		edges[0].Source().Label() != "C" || edges[0].Destination().Label() != "B" ||
+		edges[1].Source().Label() != "B" || edges[1].Destination().Label() != "A" {
+		t.Errorf("expected the path C -> B -> A with cost 3, got %v with cost %v", edges, cost)
+	}
+}
+
+func TestShortestPathEdgesErrors(t *testing.T) {
+	if _, _, err := ShortestPathEdges(gograph.New[string](), "A", "B"); !errors.Is(err, ErrNotWeighted) {
+		t.Errorf("expected error %v, got %v", ErrNotWeighted, err)
+	}
+
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"), gograph.WithEdgeWeight(-1))
+	g.AddVertexByLabel("C")
+
+	if _, _, err := ShortestPathEdges(g, "A", "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %v, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if _, _, err := ShortestPathEdges(g, "A", "B"); !errors.Is(err, ErrNegativeEdgeWeight) {
+		t.Errorf("expected error %v, got %v", ErrNegativeEdgeWeight, err)
+	}
+
+	if _, _, err := ShortestPathEdges(g, "B", "A"); !errors.Is(err, ErrNoPath) {
+		t.Errorf("expected error %v, got %v", ErrNoPath, err)
+	}
+}
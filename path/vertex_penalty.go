@@ -0,0 +1,123 @@
+package path
+
+import (
+	"errors"
+	"math"
+
+	"github.com/gavinhailey/gograph"
+	"github.com/gavinhailey/gograph/util"
+)
+
+var (
+	// ErrNoPath is returned when the destination vertex is not reachable
+	// from the source vertex.
+	ErrNoPath = errors.New("no path between the vertices")
+)
+
+// PenaltyOptionFunc represent an alias of function type that modifies
+// the options of ShortestPathWithVertexPenalty.
+type PenaltyOptionFunc func(options *penaltyOptions)
+
+type penaltyOptions struct {
+	includeSource      bool
+	includeDestination bool
+}
+
+// WithEndpointPenalties sets whether the penalties of the source and the
+// destination vertices are added to the path cost. By default, only the
+// vertices that the path passes through are penalized.
+func WithEndpointPenalties(source, destination bool) PenaltyOptionFunc {
+	return func(options *penaltyOptions) {
+		options.includeSource = source
+		options.includeDestination = destination
+	}
+}
+
+// ShortestPathWithVertexPenalty finds the cheapest path from the 'from' vertex
+// to the 'to' vertex in a weighted graph, where passing through a vertex adds
+// its penalty to the path cost, e.g., the cost of an intersection in routing.
+// It runs Dijkstra's algorithm with the penalty of each vertex folded into the
+// weights of its incoming edges, so the edge weights and the penalties must
+// not be negative.
+//
+// It returns the vertices of the path, including both endpoints, and its cost.
+//
+// It returns ErrNotWeighted if the graph is not weighted, ErrVertexDoesNotExist
+// if any of the vertices doesn't exist, and ErrNoPath if the 'to' vertex is not
+// reachable from the 'from' vertex.
+func ShortestPathWithVertexPenalty[T comparable](
+	g gograph.Graph[T],
+	from, to T,
+	penalty func(T) float64,
+	options ...PenaltyOptionFunc,
+) ([]*gograph.Vertex[T], float64, error) {
+	if !g.IsWeighted() {
+		return nil, 0, ErrNotWeighted
+	}
+
+	source := g.GetVertexByID(from)
+	if source == nil || g.GetVertexByID(to) == nil {
+		return nil, 0, gograph.ErrVertexDoesNotExist
+	}
+
+	var opts penaltyOptions
+	for _, option := range options {
+		option(&opts)
+	}
+
+	// the penalty of an endpoint is counted once, even if the path is empty
+	if from == to {
+		var cost float64
+		if opts.includeSource || opts.includeDestination {
+			cost = penalty(from)
+		}
+		return []*gograph.Vertex[T]{source}, cost, nil
+	}
+
+	dist := map[T]float64{from: 0}
+	if opts.includeSource {
+		dist[from] = penalty(from)
+	}
+	prev := make(map[T]T)
+	visited := make(map[T]bool)
+
+	pq := util.NewVertexPriorityQueue[T]()
+	pq.Push(util.NewVertexWithPriority(source, dist[from]))
+	for pq.Len() > 0 {
+		curr := pq.Pop().Vertex()
+		if visited[curr.Label()] {
+			continue
+		}
+		visited[curr.Label()] = true
+
+		if curr.Label() == to {
+			break
+		}
+
+		for _, neighbor := range curr.Neighbors() {
+			label := neighbor.Label()
+			if visited[label] {
+				continue
+			}
+
+			cost := dist[curr.Label()] + g.GetEdge(curr, neighbor).Weight()
+			if label != to || opts.includeDestination {
+				cost += penalty(label)
+			}
+
+			if d, ok := dist[label]; !ok || cost < d {
+				dist[label] = cost
+				prev[label] = curr.Label()
+				pq.Push(util.NewVertexWithPriority(g.GetVertexByID(label), cost))
+			}
+		}
+	}
+
+	cost, ok := dist[to]
+	if !ok || math.IsInf(cost, 1) {
+		return nil, 0, ErrNoPath
+	}
+
+	path, _ := ReconstructPath(g, prev, from, to)
+	return path, cost, nil
+}
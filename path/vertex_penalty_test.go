@@ -0,0 +1,110 @@
+package path
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// initPenaltyTestGraph creates the following weighted graph, where H is a hub
+// on the cheapest route from A to D, and the detour through B and C is longer.
+//
+//	A -1- H -1- D
+//	|           |
+//	2           2
+//	|           |
+//	B ----2---- C
+func initPenaltyTestGraph() gograph.Graph[string] {
+	g := gograph.New[string](gograph.Weighted())
+
+	edges := []struct {
+		from, to string
+		weight   float64
+	}{
+		{"A", "H", 1}, {"H", "D", 1}, {"A", "B", 2}, {"B", "C", 2}, {"C", "D", 2},
+	}
+	for _, e := range edges {
+		_, _ = g.AddEdge(gograph.NewVertex(e.from), gograph.NewVertex(e.to), gograph.WithEdgeWeight(e.weight))
+	}
+
+	return g
+}
+
+func TestShortestPathWithVertexPenalty(t *testing.T) {
+	g := initPenaltyTestGraph()
+
+	tests := []struct {
+		name     string
+		penalty  map[string]float64
+		options  []PenaltyOptionFunc
+		expected []string
+		cost     float64
+	}{
+		{
+			name:     "no penalty",
+			penalty:  map[string]float64{},
+			expected: []string{"A", "H", "D"},
+			cost:     2,
+		},
+		{
+			name:     "high-penalty hub forces a detour",
+			penalty:  map[string]float64{"H": 10, "B": 1, "C": 1},
+			expected: []string{"A", "B", "C", "D"},
+			cost:     8,
+		},
+		{
+			name:     "endpoint penalties are excluded by default",
+			penalty:  map[string]float64{"A": 100, "D": 100},
+			expected: []string{"A", "H", "D"},
+			cost:     2,
+		},
+		{
+			name:     "endpoint penalties are included",
+			penalty:  map[string]float64{"A": 3, "D": 4},
+			options:  []PenaltyOptionFunc{WithEndpointPenalties(true, true)},
+			expected: []string{"A", "H", "D"},
+			cost:     9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, cost, err := ShortestPathWithVertexPenalty(g, "A", "D", func(label string) float64 {
+				return tt.penalty[label]
+			}, tt.options...)
+			if err != nil {
+				t.Fatalf("Expected no errors, but get an err: %s", err)
+			}
+
+			if got := labelsOf(path); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected path %v, got %v", tt.expected, got)
+			}
+
+			if cost != tt.cost {
+				t.Errorf("Expected cost %f, got %f", tt.cost, cost)
+			}
+		})
+	}
+}
+
+func TestShortestPathWithVertexPenaltyErrors(t *testing.T) {
+	noPenalty := func(string) float64 { return 0 }
+
+	g := initPenaltyTestGraph()
+	g.AddVertexByLabel("E")
+
+	if _, _, err := ShortestPathWithVertexPenalty(g, "A", "X", noPenalty); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %v, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if _, _, err := ShortestPathWithVertexPenalty(g, "A", "E", noPenalty); !errors.Is(err, ErrNoPath) {
+		t.Errorf("Expected error %v, got %v", ErrNoPath, err)
+	}
+
+	unweighted := gograph.New[string]()
+	if _, _, err := ShortestPathWithVertexPenalty(unweighted, "A", "B", noPenalty); !errors.Is(err, ErrNotWeighted) {
+		t.Errorf("Expected error %v, got %v", ErrNotWeighted, err)
+	}
+}
@@ -0,0 +1,57 @@
+package path
+
+import (
+	"math"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// WeightedTransitiveClosure returns the weighted transitive closure of a
+// directed weighted graph, which is a new graph with the same vertices, and
+// an edge from u to v for each pair of distinct vertices where v is reachable
+// from u. The weight of each edge is the shortest-path cost from u to v, so
+// the result is the all-pairs shortest paths materialized as a graph. The
+// unreachable pairs have no edge.
+//
+// It computes the distances by FloydWarshall, so the time complexity is O(V^3).
+// The input graph is not modified.
+//
+// It returns ErrNotWeighted if the graph is not weighted, ErrNotDirected if the
+// graph is not directed, and ErrNegativeWeightCycle if the graph contains a
+// negative weight cycle.
+func WeightedTransitiveClosure[T comparable](g gograph.Graph[T]) (gograph.Graph[T], error) {
+	dist, err := FloydWarshall(g)
+	if err != nil {
+		return nil, err
+	}
+
+	closure := gograph.New[T](gograph.Directed(), gograph.Weighted())
+	vertices := g.GetAllVertices()
+	for _, v := range vertices {
+		closure.AddVertexByLabel(v.Label(), gograph.WithVertexWeight(v.Weight()))
+	}
+
+	for _, source := range vertices {
+		for _, dest := range vertices {
+			if source.Label() == dest.Label() {
+				continue
+			}
+
+			cost := dist[source.Label()][dest.Label()]
+			if math.IsInf(cost, 1) {
+				continue
+			}
+
+			_, err = closure.AddEdge(
+				closure.GetVertexByID(source.Label()),
+				closure.GetVertexByID(dest.Label()),
+				gograph.WithEdgeWeight(cost),
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return closure, nil
+}
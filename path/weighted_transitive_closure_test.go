@@ -0,0 +1,66 @@
+package path
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestWeightedTransitiveClosure(t *testing.T) {
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	edges := []struct {
+		from, to string
+		weight   float64
+	}{
+		{"A", "B", 4}, {"A", "C", 1}, {"C", "B", 2}, {"B", "D", 1}, {"D", "E", 3}, {"E", "D", 1},
+	}
+	for _, e := range edges {
+		_, _ = g.AddEdge(gograph.NewVertex(e.from), gograph.NewVertex(e.to), gograph.WithEdgeWeight(e.weight))
+	}
+	g.AddVertexByLabel("F")
+
+	closure, err := WeightedTransitiveClosure(g)
+	if err != nil {
+		t.Fatalf("Expected no errors, but get an err: %s", err)
+	}
+
+	if closure.Order() != g.Order() {
+		t.Errorf("Expected %d vertices, got %d", g.Order(), closure.Order())
+	}
+
+	// every edge matches the Dijkstra distance, and every reachable
+	// pair has an edge.
+	var reachablePairs int
+	for _, source := range g.GetAllVertices() {
+		for dest, dist := range Dijkstra(g, source.Label()) {
+			edge := closure.GetEdge(closure.GetVertexByID(source.Label()), closure.GetVertexByID(dest))
+			if dest == source.Label() || dist == math.MaxFloat64 {
+				if edge != nil {
+					t.Errorf("Expected no edge from %s to %s", source.Label(), dest)
+				}
+				continue
+			}
+
+			reachablePairs++
+			if edge == nil || edge.Weight() != dist {
+				t.Errorf("Expected edge from %s to %s with weight %v, got %v", source.Label(), dest, dist, edge)
+			}
+		}
+	}
+
+	if int(closure.Size()) != reachablePairs {
+		t.Errorf("Expected %d edges, got %d", reachablePairs, closure.Size())
+	}
+}
+
+func TestWeightedTransitiveClosureNegativeCycle(t *testing.T) {
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"), gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(gograph.NewVertex("B"), gograph.NewVertex("A"), gograph.WithEdgeWeight(-2))
+
+	if _, err := WeightedTransitiveClosure(g); !errors.Is(err, ErrNegativeWeightCycle) {
+		t.Errorf("Expected error %v, got %v", ErrNegativeWeightCycle, err)
+	}
+}
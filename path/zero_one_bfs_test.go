@@ -0,0 +1,66 @@
+package path
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestZeroOneBFS(t *testing.T) {
+	// a 4x4 grid where the moves to the right are free
+	g := gograph.New[int](gograph.Directed(), gograph.Weighted())
+	for row := 0; row < 4; row++ {
+		for col := 0; col < 4; col++ {
+			label := row*4 + col
+			if col < 3 {
+				_, _ = g.AddEdge(gograph.NewVertex(label), gograph.NewVertex(label+1), gograph.WithEdgeWeight(0))
+				_, _ = g.AddEdge(gograph.NewVertex(label+1), gograph.NewVertex(label), gograph.WithEdgeWeight(1))
+			}
+
+			if row < 3 {
+				_, _ = g.AddEdge(gograph.NewVertex(label), gograph.NewVertex(label+4), gograph.WithEdgeWeight(1))
+				_, _ = g.AddEdge(gograph.NewVertex(label+4), gograph.NewVertex(label), gograph.WithEdgeWeight(1))
+			}
+		}
+	}
+	g.AddVertexByLabel(100)
+
+	dist, err := ZeroOneBFS(g, 5)
+	if err != nil {
+		t.Fatalf("Expected no errors, but get an err: %s", err)
+	}
+
+	expected := Dijkstra(g, 5)
+	for label, want := range expected {
+		got, ok := dist[label]
+		if want == math.MaxFloat64 {
+			if ok {
+				t.Errorf("Expected %d to be unreachable, got %d", label, got)
+			}
+			continue
+		}
+
+		if !ok || float64(got) != want {
+			t.Errorf("Expected distance of %d to be %v, got %d", label, want, got)
+		}
+	}
+}
+
+func TestZeroOneBFSErrors(t *testing.T) {
+	g := gograph.New[int](gograph.Weighted())
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2), gograph.WithEdgeWeight(2))
+
+	if _, err := ZeroOneBFS(g, 1); !errors.Is(err, ErrNotZeroOneWeighted) {
+		t.Errorf("Expected error %v, got %v", ErrNotZeroOneWeighted, err)
+	}
+
+	if _, err := ZeroOneBFS(g, 3); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %v, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if _, err := ZeroOneBFS(gograph.New[int](), 1); !errors.Is(err, ErrNotWeighted) {
+		t.Errorf("Expected error %v, got %v", ErrNotWeighted, err)
+	}
+}
@@ -0,0 +1,122 @@
+package gograph
+
+// Pair is the label of a vertex of a graph product, which combines the label
+// of a vertex of the first graph with the label of a vertex of the second
+// graph.
+type Pair[T, U comparable] struct {
+	First  T
+	Second U
+}
+
+// CartesianProduct returns the Cartesian product of the specified graphs,
+// which has a vertex (u, v) for each vertex u of a and each vertex v of b.
+// It connects (u1, v) to (u2, v) when there is an edge from u1 to u2 in a,
+// and (u, v1) to (u, v2) when there is an edge from v1 to v2 in b. For
+// example, the Cartesian product of two paths is a grid.
+//
+// Each edge of the product has the weight of the edge it comes from. The
+// returned graph has the same type as the input graphs, and they are not
+// modified.
+//
+// It returns ErrGraphTypeMismatch if the graphs differ in being directed or
+// weighted.
+func CartesianProduct[T, U comparable](a Graph[T], b Graph[U]) (Graph[Pair[T, U]], error) {
+	product, err := newProduct(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, edge := range a.AllEdges() {
+		for _, v := range b.GetAllVertices() {
+			addProductEdge(
+				product,
+				Pair[T, U]{First: edge.source.label, Second: v.label},
+				Pair[T, U]{First: edge.dest.label, Second: v.label},
+				edge.Weight(),
+			)
+		}
+	}
+
+	for _, edge := range b.AllEdges() {
+		for _, u := range a.GetAllVertices() {
+			addProductEdge(
+				product,
+				Pair[T, U]{First: u.label, Second: edge.source.label},
+				Pair[T, U]{First: u.label, Second: edge.dest.label},
+				edge.Weight(),
+			)
+		}
+	}
+
+	return product, nil
+}
+
+// TensorProduct returns the tensor product of the specified graphs, also
+// known as the direct or Kronecker product, which has a vertex (u, v) for
+// each vertex u of a and each vertex v of b. It connects (u1, v1) to
+// (u2, v2) when there is an edge from u1 to u2 in a and an edge from v1 to
+// v2 in b.
+//
+// Each edge of the product has the product of the weights of the edges it
+// comes from, so the weighted adjacency matrix of the result is the
+// Kronecker product of the matrices of the input graphs. The returned graph
+// has the same type as the input graphs, and they are not modified.
+//
+// It returns ErrGraphTypeMismatch if the graphs differ in being directed or
+// weighted.
+func TensorProduct[T, U comparable](a Graph[T], b Graph[U]) (Graph[Pair[T, U]], error) {
+	product, err := newProduct(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, edgeA := range a.AllEdges() {
+		for _, edgeB := range b.AllEdges() {
+			addProductEdge(
+				product,
+				Pair[T, U]{First: edgeA.source.label, Second: edgeB.source.label},
+				Pair[T, U]{First: edgeA.dest.label, Second: edgeB.dest.label},
+				edgeA.Weight()*edgeB.Weight(),
+			)
+		}
+	}
+
+	return product, nil
+}
+
+// newProduct returns a graph of the same type as the input graphs, with a
+// vertex for each pair of their vertices.
+func newProduct[T, U comparable](a Graph[T], b Graph[U]) (Graph[Pair[T, U]], error) {
+	if a.IsDirected() != b.IsDirected() || a.IsWeighted() != b.IsWeighted() {
+		return nil, ErrGraphTypeMismatch
+	}
+
+	var options []GraphOptionFunc
+	if a.IsDirected() {
+		options = append(options, Directed())
+	}
+
+	if a.IsWeighted() {
+		options = append(options, Weighted())
+	}
+
+	product := New[Pair[T, U]](options...)
+	for _, u := range a.GetAllVertices() {
+		for _, v := range b.GetAllVertices() {
+			product.AddVertexByLabel(Pair[T, U]{First: u.label, Second: v.label})
+		}
+	}
+
+	return product, nil
+}
+
+// addProductEdge adds an edge between the specified vertices of the product.
+// Undirected edges are stored in both directions, so the same edge may be
+// added twice, and the second one is ignored.
+func addProductEdge[T, U comparable](product Graph[Pair[T, U]], from, to Pair[T, U], weight float64) {
+	_, _ = product.AddEdge(
+		product.GetVertexByID(from),
+		product.GetVertexByID(to),
+		WithEdgeWeight(weight),
+	)
+}
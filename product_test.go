@@ -0,0 +1,118 @@
+package gograph
+
+import (
+	"errors"
+	"testing"
+)
+
+func newPathGraph(n int, options ...GraphOptionFunc) Graph[int] {
+	g := New[int](options...)
+	g.AddVertexByLabel(0)
+	for i := 1; i < n; i++ {
+		_, _ = g.AddEdge(NewVertex(i-1), NewVertex(i), WithEdgeWeight(float64(i)))
+	}
+
+	return g
+}
+
+func TestCartesianProduct(t *testing.T) {
+	product, err := CartesianProduct(newPathGraph(2), newPathGraph(3))
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if product.Order() != 6 {
+		t.Errorf(testErrMsgWrongLen, 6, product.Order())
+	}
+
+	// the 2x3 grid has 7 undirected edges
+	if product.Size() != 14 {
+		t.Errorf(testErrMsgWrongLen, 14, product.Size())
+	}
+
+	edges := [][2]Pair[int, int]{
+		{{0, 0}, {0, 1}}, {{0, 1}, {0, 2}},
+		{{1, 0}, {1, 1}}, {{1, 1}, {1, 2}},
+		{{0, 0}, {1, 0}}, {{0, 1}, {1, 1}}, {{0, 2}, {1, 2}},
+	}
+	for _, e := range edges {
+		if !product.HasEdge(e[0], e[1]) {
+			t.Errorf("Expected edge between %v and %v", e[0], e[1])
+		}
+	}
+
+	if product.HasEdge(Pair[int, int]{0, 0}, Pair[int, int]{1, 1}) {
+		t.Error(testErrMsgNotFalse)
+	}
+}
+
+func TestCartesianProductDirectedWeighted(t *testing.T) {
+	a := newPathGraph(2, Directed(), Weighted())
+	b := newPathGraph(3, Directed(), Weighted())
+
+	product, err := CartesianProduct(a, b)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if !product.IsDirected() || !product.IsWeighted() {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	if product.Size() != 7 {
+		t.Errorf(testErrMsgWrongLen, 7, product.Size())
+	}
+
+	edge := product.GetEdge(product.GetVertexByID(Pair[int, int]{1, 1}), product.GetVertexByID(Pair[int, int]{1, 2}))
+	if edge == nil || edge.Weight() != 2 {
+		t.Errorf(testErrMsgNotEqual, 2, edge)
+	}
+
+	if product.HasEdge(Pair[int, int]{1, 0}, Pair[int, int]{0, 0}) {
+		t.Error(testErrMsgNotFalse)
+	}
+}
+
+func TestTensorProduct(t *testing.T) {
+	product, err := TensorProduct(newPathGraph(2), newPathGraph(3))
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if product.Order() != 6 {
+		t.Errorf(testErrMsgWrongLen, 6, product.Order())
+	}
+
+	if product.Size() != 8 {
+		t.Errorf(testErrMsgWrongLen, 8, product.Size())
+	}
+
+	edges := [][2]Pair[int, int]{
+		{{0, 0}, {1, 1}}, {{0, 1}, {1, 0}}, {{0, 1}, {1, 2}}, {{0, 2}, {1, 1}},
+	}
+	for _, e := range edges {
+		if !product.HasEdge(e[0], e[1]) {
+			t.Errorf("Expected edge between %v and %v", e[0], e[1])
+		}
+	}
+
+	weighted, err := TensorProduct(newPathGraph(2, Directed(), Weighted()), newPathGraph(3, Directed(), Weighted()))
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	edge := weighted.GetEdge(weighted.GetVertexByID(Pair[int, int]{0, 1}), weighted.GetVertexByID(Pair[int, int]{1, 2}))
+	if edge == nil || edge.Weight() != 2 {
+		t.Errorf(testErrMsgNotEqual, 2, edge)
+	}
+}
+
+func TestProductTypeMismatch(t *testing.T) {
+	if _, err := CartesianProduct(newPathGraph(2), newPathGraph(2, Directed())); !errors.Is(err, ErrGraphTypeMismatch) {
+		t.Errorf(testErrMsgNotEqual, ErrGraphTypeMismatch, err)
+	}
+
+	if _, err := TensorProduct(newPathGraph(2), newPathGraph(2, Weighted())); !errors.Is(err, ErrGraphTypeMismatch) {
+		t.Errorf(testErrMsgNotEqual, ErrGraphTypeMismatch, err)
+	}
+}
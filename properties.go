@@ -9,6 +9,15 @@ type GraphProperties struct {
 	isDirected bool
 	isWeighted bool
 	isAcyclic  bool
+
+	// noSelfLoops is set by WithoutSelfLoops.
+	noSelfLoops bool
+
+	// labelEquality is the labelEquality[T] set by WithLabelEquality, if
+	// any. It is untyped, since the options are not generic.
+	labelEquality any
+
+	duplicateVertexPolicy DuplicateVertexPolicy
 }
 
 func newProperties(options ...GraphOptionFunc) GraphProperties {
@@ -46,6 +55,44 @@ func Weighted() GraphOptionFunc {
 	}
 }
 
+// WithoutSelfLoops returns a GraphOptionFunc that modifies the specified
+// graph properties. It makes AddEdge reject the edges from a vertex to
+// itself with ErrSelfLoop. Acyclic graphs reject them anyway, since a self
+// loop is a cycle.
+func WithoutSelfLoops() GraphOptionFunc {
+	return func(properties *GraphProperties) {
+		properties.noSelfLoops = true
+	}
+}
+
+// DuplicateVertexPolicy decides what AddVertexByLabel does when a vertex
+// with the same label already exists in the graph.
+type DuplicateVertexPolicy int
+
+const (
+	// DuplicateVertexIdempotent returns the existing vertex, leaving it
+	// untouched. The vertex options passed to AddVertexByLabel are ignored.
+	// This is the default policy.
+	DuplicateVertexIdempotent DuplicateVertexPolicy = iota
+
+	// DuplicateVertexError treats a duplicate as an error: TryAddVertex
+	// returns an error wrapping ErrVertexAlreadyExists, AddVertexByLabel
+	// returns nil and AddVertex does nothing.
+	DuplicateVertexError
+
+	// DuplicateVertexReject rejects the duplicate and returns nil, so
+	// callers can tell a new vertex from an existing one.
+	DuplicateVertexReject
+)
+
+// WithDuplicateVertexPolicy returns a GraphOptionFunc that sets how the
+// graph handles adding a vertex whose label already exists.
+func WithDuplicateVertexPolicy(policy DuplicateVertexPolicy) GraphOptionFunc {
+	return func(properties *GraphProperties) {
+		properties.duplicateVertexPolicy = policy
+	}
+}
+
 // EdgeOptionFunc represent an alias of function type that
 // modifies the specified edge properties.
 type EdgeOptionFunc func(properties *EdgeProperties)
@@ -53,6 +100,7 @@ type EdgeOptionFunc func(properties *EdgeProperties)
 // EdgeProperties represents the properties of an edge.
 type EdgeProperties struct {
 	weight float64
+	cost   float64
 }
 
 // WithEdgeWeight sets the edge weight for the specified edge
@@ -63,17 +111,28 @@ func WithEdgeWeight(weight float64) EdgeOptionFunc {
 	}
 }
 
+// WithEdgeCapacityCost sets the capacity and the cost per unit of flow for
+// the specified edge properties in the returned EdgeOptionFunc, for flow
+// problems like MinCostMaxFlow. The capacity is stored as the edge weight.
+func WithEdgeCapacityCost(capacity, cost float64) EdgeOptionFunc {
+	return func(properties *EdgeProperties) {
+		properties.weight = capacity
+		properties.cost = cost
+	}
+}
+
 // VertexOptionFunc represent an alias of function type that
 // modifies the specified vertex properties.
 type VertexOptionFunc func(properties *VertexProperties)
 
-// VertexProperties represents the properties of an edge.
+// VertexProperties represents the properties of a vertex.
 type VertexProperties struct {
 	weight float64
 }
 
-// WithVertexWeight sets the edge weight for the specified vertex
-// properties in the returned VertexOptionFunc.
+// WithVertexWeight sets the vertex weight for the specified vertex
+// properties in the returned VertexOptionFunc. The default weight
+// of a vertex is 0.
 func WithVertexWeight(weight float64) VertexOptionFunc {
 	return func(properties *VertexProperties) {
 		properties.weight = weight
@@ -0,0 +1,72 @@
+package gograph
+
+// PruneLeaves returns a new graph without the leaves of the input graph,
+// which are removed round by round. Each round removes all the vertices
+// that are leaves at the start of the round: the vertices without outgoing
+// edges in a directed graph, and the vertices with exactly one neighbor in
+// an undirected graph. A negative number of rounds prunes until no leaf is
+// left, which extracts the core of a dependency graph. Zero rounds returns
+// a copy of the graph.
+//
+// The isolated vertices of an undirected graph are not leaves, so they are
+// kept. A tree pruned until stable keeps its center if it is a single
+// vertex, which becomes isolated. If the tree has two centers, such as a
+// path with an even number of vertices, they are leaves of the same round,
+// so the tree becomes empty. A directed acyclic graph pruned until stable
+// becomes empty too, since each round removes its sinks.
+//
+// The returned graph has the same properties as the input graph, the vertex
+// and edge weights are preserved, and the input graph is not modified. The
+// returned error is always nil.
+func PruneLeaves[T comparable](g Graph[T], rounds int) (Graph[T], error) {
+	vertices := g.GetAllVertices()
+	degrees := make(map[T]int, len(vertices))
+	for _, v := range vertices {
+		degrees[v.label] = len(v.adjacent())
+	}
+
+	// the vertices whose degree drops when a vertex is removed
+	dependents := make(map[T][]*Vertex[T], len(vertices))
+	if g.IsDirected() {
+		for _, edge := range g.AllEdges() {
+			dependents[edge.dest.label] = append(dependents[edge.dest.label], edge.source)
+		}
+	} else {
+		for _, v := range vertices {
+			dependents[v.label] = v.adjacent()
+		}
+	}
+
+	isLeaf := func(label T) bool {
+		if g.IsDirected() {
+			return degrees[label] == 0
+		}
+		return degrees[label] == 1
+	}
+
+	removed := make(map[T]bool)
+	for round := 0; rounds < 0 || round < rounds; round++ {
+		var leaves []*Vertex[T]
+		for _, v := range vertices {
+			if !removed[v.label] && isLeaf(v.label) {
+				leaves = append(leaves, v)
+			}
+		}
+
+		if len(leaves) == 0 {
+			break
+		}
+
+		for _, leaf := range leaves {
+			removed[leaf.label] = true
+		}
+
+		for _, leaf := range leaves {
+			for _, dependent := range dependents[leaf.label] {
+				degrees[dependent.label]--
+			}
+		}
+	}
+
+	return induced(g, func(label T) bool { return !removed[label] }), nil
+}
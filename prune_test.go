@@ -0,0 +1,71 @@
+package gograph
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestPruneLeaves(t *testing.T) {
+	// the undirected path a - b - c - d - e
+	path := New[string]()
+	for _, e := range [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"d", "e"}} {
+		_, _ = path.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+	}
+
+	// the directed graph with the cycle x -> y -> z -> x, and the chains
+	// z -> p -> q and y -> r hanging from it
+	dependencies := New[string](Directed())
+	for _, e := range [][2]string{{"x", "y"}, {"y", "z"}, {"z", "x"}, {"z", "p"}, {"p", "q"}, {"y", "r"}} {
+		_, _ = dependencies.AddEdge(NewVertex(e[0]), NewVertex(e[1]))
+	}
+
+	tests := []struct {
+		name     string
+		g        Graph[string]
+		rounds   int
+		expected []string
+	}{
+		{"path zero rounds", path, 0, []string{"a", "b", "c", "d", "e"}},
+		{"path one round", path, 1, []string{"b", "c", "d"}},
+		{"path until stable", path, -1, []string{"c"}},
+		{"path more rounds than needed", path, 10, []string{"c"}},
+		{"directed one round", dependencies, 1, []string{"p", "x", "y", "z"}},
+		{"directed until stable", dependencies, -1, []string{"x", "y", "z"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pruned, err := PruneLeaves(tt.g, tt.rounds)
+			if err != nil {
+				t.Fatalf(testErrMsgError, err)
+			}
+
+			labels := extractLabels(pruned.GetAllVertices())
+			sort.Strings(labels)
+			if !reflect.DeepEqual(labels, tt.expected) {
+				t.Errorf(testErrMsgNotEqual, tt.expected, labels)
+			}
+
+			if pruned.IsDirected() != tt.g.IsDirected() {
+				t.Error(testErrMsgNotTrue)
+			}
+		})
+	}
+
+	// the input graph is not modified
+	if path.Order() != 5 || dependencies.Order() != 6 {
+		t.Error("expected the input graphs to be unchanged")
+	}
+
+	// an even path is pruned completely
+	even := New[int]()
+	_, _ = even.AddEdge(NewVertex(1), NewVertex(2))
+	_, _ = even.AddEdge(NewVertex(2), NewVertex(3))
+	_, _ = even.AddEdge(NewVertex(3), NewVertex(4))
+
+	pruned, _ := PruneLeaves(even, -1)
+	if pruned.Order() != 0 {
+		t.Errorf(testErrMsgWrongLen, 0, pruned.Order())
+	}
+}
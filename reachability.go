@@ -0,0 +1,109 @@
+package gograph
+
+// ReachabilityIndex answers whether a vertex of a directed graph can reach
+// another one in constant time, by precomputing the transitive closure of
+// the graph. It is meant for many queries on a static graph, where running
+// a BFS per query is wasteful.
+//
+// The index collapses each strongly connected component into one node, and
+// stores for each component the set of components reachable from it as a
+// bitset, so the index takes O(C^2/64) words for C components, and building
+// it takes O(C*(V+E)/64) time.
+//
+// The index tracks the changes of the graph. If the graph has been modified
+// since the index was built, the next query rebuilds it first. For the
+// graphs created by this package, every change is detected. For the other
+// implementations of Graph, only the changes of the number of vertices or
+// edges are detected, so call Rebuild after the other changes.
+type ReachabilityIndex[T comparable] struct {
+	graph       Graph[T]
+	component   map[T]int
+	reach       [][]uint64
+	fingerprint [3]uint64
+}
+
+// NewReachabilityIndex builds a reachability index of the specified
+// directed graph.
+//
+// It returns ErrNotDirected if the graph is undirected, in which case the
+// connected components answer reachability.
+func NewReachabilityIndex[T comparable](g Graph[T]) (*ReachabilityIndex[T], error) {
+	if !g.IsDirected() {
+		return nil, ErrNotDirected
+	}
+
+	index := &ReachabilityIndex[T]{graph: g}
+	index.Rebuild()
+
+	return index, nil
+}
+
+// Reaches reports whether there is a path from the vertex a to the vertex b.
+// Every vertex reaches itself. If any of the vertices doesn't exist, it
+// returns false. If the graph has changed since the index was built, it
+// rebuilds the index first.
+func (r *ReachabilityIndex[T]) Reaches(a, b T) bool {
+	if r.stale() {
+		r.Rebuild()
+	}
+
+	from, ok := r.component[a]
+	if !ok {
+		return false
+	}
+
+	to, ok := r.component[b]
+	if !ok {
+		return false
+	}
+
+	return r.reach[from][to/64]&(1<<(to%64)) != 0
+}
+
+// Rebuild rebuilds the index from the current state of the graph.
+func (r *ReachabilityIndex[T]) Rebuild() {
+	// The components come in reverse topological order, so the components
+	// reachable from a component are computed before it.
+	components := stronglyConnectedComponents(r.graph)
+	words := (len(components) + 63) / 64
+
+	r.component = make(map[T]int, r.graph.Order())
+	r.reach = make([][]uint64, len(components))
+	for c, component := range components {
+		for _, v := range component {
+			r.component[v.label] = c
+		}
+
+		reach := make([]uint64, words)
+		reach[c/64] |= 1 << (c % 64)
+		for _, v := range component {
+			for _, neighbor := range v.adjacent() {
+				other := r.component[neighbor.label]
+				if other == c || reach[other/64]&(1<<(other%64)) != 0 {
+					continue
+				}
+
+				for i, word := range r.reach[other] {
+					reach[i] |= word
+				}
+			}
+		}
+
+		r.reach[c] = reach
+	}
+
+	r.fingerprint = r.currentFingerprint()
+}
+
+func (r *ReachabilityIndex[T]) stale() bool {
+	return r.fingerprint != r.currentFingerprint()
+}
+
+func (r *ReachabilityIndex[T]) currentFingerprint() [3]uint64 {
+	var modifications uint64
+	if m, ok := r.graph.(interface{ modifications() uint64 }); ok {
+		modifications = m.modifications()
+	}
+
+	return [3]uint64{uint64(r.graph.Order()), uint64(r.graph.Size()), modifications}
+}
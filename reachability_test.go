@@ -0,0 +1,116 @@
+package gograph
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+// bfsReaches reports whether b is reachable from a by a BFS.
+func bfsReaches[T comparable](g Graph[T], a, b T) bool {
+	start := g.GetVertexByID(a)
+	if start == nil || g.GetVertexByID(b) == nil {
+		return false
+	}
+
+	visited := map[T]bool{a: true}
+	queue := []*Vertex[T]{start}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+		if curr.label == b {
+			return true
+		}
+
+		for _, neighbor := range curr.neighbors {
+			if !visited[neighbor.label] {
+				visited[neighbor.label] = true
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	return false
+}
+
+func newRandomDirectedGraph(n, m int, seed int64) Graph[int] {
+	rng := rand.New(rand.NewSource(seed))
+	g := New[int](Directed())
+	for i := 0; i < n; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 0; i < m; i++ {
+		_, _ = g.AddEdge(g.GetVertexByID(rng.Intn(n)), g.GetVertexByID(rng.Intn(n)))
+	}
+
+	return g
+}
+
+func TestReachabilityIndex(t *testing.T) {
+	// more than 64 components, with some cycles
+	g := newRandomDirectedGraph(150, 180, 1)
+
+	index, err := NewReachabilityIndex(g)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	for a := 0; a < 150; a++ {
+		for b := 0; b < 150; b++ {
+			if got, want := index.Reaches(a, b), bfsReaches(g, a, b); got != want {
+				t.Fatalf("Expected Reaches(%d, %d) to be %v, got %v", a, b, want, got)
+			}
+		}
+	}
+
+	if index.Reaches(0, 1000) || index.Reaches(1000, 0) {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	if _, err = NewReachabilityIndex(New[int]()); !errors.Is(err, ErrNotDirected) {
+		t.Errorf(testErrMsgNotEqual, ErrNotDirected, err)
+	}
+}
+
+func TestReachabilityIndexTracksChanges(t *testing.T) {
+	g := New[string](Directed())
+	_, _ = g.AddEdge(NewVertex("A"), NewVertex("B"))
+	_, _ = g.AddEdge(NewVertex("C"), NewVertex("D"))
+
+	index, _ := NewReachabilityIndex(g)
+	if index.Reaches("A", "D") {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	_, _ = g.AddEdge(NewVertex("B"), NewVertex("C"))
+	if !index.Reaches("A", "D") {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	// replacing an edge keeps the number of vertices and edges
+	g.RemoveEdges(g.GetEdge(g.GetVertexByID("B"), g.GetVertexByID("C")))
+	_, _ = g.AddEdge(NewVertex("D"), NewVertex("A"))
+	if index.Reaches("A", "D") || !index.Reaches("D", "B") {
+		t.Error("Expected the index to be rebuilt after the graph changed")
+	}
+}
+
+func BenchmarkReachabilityIndex_Reaches(b *testing.B) {
+	g := newRandomDirectedGraph(1000, 1500, 1)
+	index, _ := NewReachabilityIndex(g)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index.Reaches(i%1000, (i*7)%1000)
+	}
+}
+
+func BenchmarkReachabilityBFS(b *testing.B) {
+	g := newRandomDirectedGraph(1000, 1500, 1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bfsReaches(g, i%1000, (i*7)%1000)
+	}
+}
@@ -0,0 +1,20 @@
+package gograph
+
+// IsSimple reports whether the specified graph is simple, which means it
+// has neither self-loops nor parallel edges between the same pair of
+// vertices, in the same direction.
+func IsSimple[T comparable](g Graph[T]) bool {
+	for _, v := range g.GetAllVertices() {
+		neighbors := v.adjacent()
+		seen := make(map[T]bool, len(neighbors))
+		for _, neighbor := range neighbors {
+			if neighbor.label == v.label || seen[neighbor.label] {
+				return false
+			}
+
+			seen[neighbor.label] = true
+		}
+	}
+
+	return true
+}
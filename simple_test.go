@@ -0,0 +1,45 @@
+package gograph
+
+import "testing"
+
+func TestIsSimple(t *testing.T) {
+	g := New[int]()
+	if !IsSimple(g) {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+	_, _ = g.AddEdge(v1, v2)
+	_, _ = g.AddEdge(v2, v3)
+	_, _ = g.AddEdge(v3, v1)
+
+	if !IsSimple(g) {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	_, _ = g.AddEdge(v2, v2)
+	if IsSimple(g) {
+		t.Error(testErrMsgNotFalse)
+	}
+}
+
+func TestIsSimpleDirected(t *testing.T) {
+	g := New[int](Directed())
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+
+	// edges in opposite directions are not parallel
+	_, _ = g.AddEdge(v1, v2)
+	_, _ = g.AddEdge(v2, v1)
+
+	if !IsSimple(g) {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	_, _ = g.AddEdge(v1, v1)
+	if IsSimple(g) {
+		t.Error(testErrMsgNotFalse)
+	}
+}
@@ -0,0 +1,69 @@
+package gograph
+
+// snapshotGraph is a read-only implementation of the Graph interface, that
+// owns a deep copy of another graph. It embeds the copy for the read
+// methods, and rejects the mutations.
+type snapshotGraph[T comparable] struct {
+	*baseGraph[T]
+}
+
+// Snapshot returns an immutable deep copy of the graph. Since nothing can
+// modify the copy, it is safe to read and traverse it from any number of
+// goroutines, while the input graph keeps being modified by its owner.
+//
+// The copy is made eagerly, so Snapshot itself must not be called
+// concurrently with the mutations of the input graph. The vertices and
+// edges returned by the snapshot belong to it and must not be modified
+// either, e.g. by SetVertexWeight.
+//
+// The mutations of the snapshot are rejected: the methods that return error
+// return ErrReadOnlyGraph, and the other ones do nothing.
+func Snapshot[T comparable](g Graph[T]) Graph[T] {
+	return &snapshotGraph[T]{baseGraph: induced(g, nil)}
+}
+
+// AddEdge returns ErrReadOnlyGraph, since the snapshot is read-only.
+func (s *snapshotGraph[T]) AddEdge(_, _ *Vertex[T], _ ...EdgeOptionFunc) (*Edge[T], error) {
+	return nil, ErrReadOnlyGraph
+}
+
+// GetOrAddEdge returns the existing edge from the 'from' vertex to the 'to'
+// vertex, or ErrReadOnlyGraph if it doesn't exist, since the snapshot is
+// read-only.
+func (s *snapshotGraph[T]) GetOrAddEdge(from, to *Vertex[T], _ ...EdgeOptionFunc) (*Edge[T], bool, error) {
+	if edge := s.GetEdge(from, to); edge != nil {
+		return edge, false, nil
+	}
+
+	return nil, false, ErrReadOnlyGraph
+}
+
+// RemoveEdges does nothing, since the snapshot is read-only.
+func (s *snapshotGraph[T]) RemoveEdges(_ ...*Edge[T]) {}
+
+// AddVertexByLabel returns nil, since the snapshot is read-only.
+func (s *snapshotGraph[T]) AddVertexByLabel(_ T, _ ...VertexOptionFunc) *Vertex[T] {
+	return nil
+}
+
+// TryAddVertex returns ErrReadOnlyGraph, since the snapshot is read-only.
+func (s *snapshotGraph[T]) TryAddVertex(_ T, _ ...VertexOptionFunc) (*Vertex[T], error) {
+	return nil, ErrReadOnlyGraph
+}
+
+// AddVertex does nothing, since the snapshot is read-only.
+func (s *snapshotGraph[T]) AddVertex(_ *Vertex[T]) {}
+
+// RemoveVertices does nothing, since the snapshot is read-only.
+func (s *snapshotGraph[T]) RemoveVertices(_ ...*Vertex[T]) {}
+
+// RemoveVerticesByLabel returns ErrReadOnlyGraph, since the snapshot is
+// read-only.
+func (s *snapshotGraph[T]) RemoveVerticesByLabel(_ ...T) error {
+	return ErrReadOnlyGraph
+}
+
+// readOnly reports that the snapshot rejects the mutations.
+func (s *snapshotGraph[T]) readOnly() bool {
+	return true
+}
@@ -0,0 +1,107 @@
+package gograph
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestSnapshot(t *testing.T) {
+	g := New[int](Directed(), Weighted())
+	for i := 1; i < 10; i++ {
+		_, _ = g.AddEdge(NewVertex(i-1), NewVertex(i), WithEdgeWeight(float64(i)))
+	}
+
+	snapshot := Snapshot(g)
+	if snapshot.Order() != 10 || snapshot.Size() != 9 {
+		t.Errorf("Expected 10 vertices and 9 edges, got %d and %d", snapshot.Order(), snapshot.Size())
+	}
+
+	if !snapshot.IsDirected() || !snapshot.IsWeighted() {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	if _, err := snapshot.AddEdge(NewVertex(9), NewVertex(0)); !errors.Is(err, ErrReadOnlyGraph) {
+		t.Errorf(testErrMsgNotEqual, ErrReadOnlyGraph, err)
+	}
+
+	if edge, created, err := snapshot.GetOrAddEdge(NewVertex(0), NewVertex(1)); err != nil || created || edge.Weight() != 1 {
+		t.Errorf(testErrMsgNotEqual, 1, edge)
+	}
+
+	if _, _, err := snapshot.GetOrAddEdge(NewVertex(9), NewVertex(0)); !errors.Is(err, ErrReadOnlyGraph) {
+		t.Errorf(testErrMsgNotEqual, ErrReadOnlyGraph, err)
+	}
+
+	if _, err := snapshot.TryAddVertex(9); !errors.Is(err, ErrReadOnlyGraph) {
+		t.Errorf(testErrMsgNotEqual, ErrReadOnlyGraph, err)
+	}
+
+	if err := snapshot.RemoveVerticesByLabel(0); !errors.Is(err, ErrReadOnlyGraph) {
+		t.Errorf(testErrMsgNotEqual, ErrReadOnlyGraph, err)
+	}
+
+	if v := snapshot.AddVertexByLabel(42); v != nil {
+		t.Errorf(testErrMsgNotEqual, nil, v)
+	}
+
+	snapshot.AddVertex(NewVertex(43))
+	snapshot.RemoveVertices(snapshot.GetVertexByID(0))
+	snapshot.RemoveEdges(snapshot.AllEdges()...)
+	if snapshot.Order() != 10 || snapshot.Size() != 9 {
+		t.Errorf("Expected 10 vertices and 9 edges, got %d and %d", snapshot.Order(), snapshot.Size())
+	}
+
+	// mutating the source doesn't affect the snapshot
+	_ = g.RemoveVerticesByLabel(5)
+	if snapshot.GetVertexByID(5) == nil || !snapshot.HasEdge(4, 5) {
+		t.Error("Expected the snapshot not to change with the source graph")
+	}
+}
+
+// TestSnapshotConcurrentRead is meant to be run with the race detector.
+func TestSnapshotConcurrentRead(t *testing.T) {
+	g := New[int](Directed())
+	for i := 1; i < 100; i++ {
+		_, _ = g.AddEdge(NewVertex(i-1), NewVertex(i))
+	}
+
+	snapshot := Snapshot(g)
+
+	var wg sync.WaitGroup
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for pass := 0; pass < 20; pass++ {
+				var visited int
+				for v := snapshot.GetVertexByID(0); v != nil; visited++ {
+					neighbors := v.Neighbors()
+					if len(neighbors) == 0 {
+						v = nil
+						continue
+					}
+					v = snapshot.GetVertexByID(neighbors[0].Label())
+				}
+
+				if visited != 100 {
+					t.Errorf("Expected to visit 100 vertices, got %d", visited)
+					return
+				}
+
+				_ = snapshot.AllEdges()
+			}
+		}()
+	}
+
+	// mutate the source on this goroutine while the snapshot is read
+	for i := 100; i < 1000; i++ {
+		_, _ = g.AddEdge(NewVertex(i-1), NewVertex(i))
+		if i%10 == 0 {
+			_ = g.RemoveVerticesByLabel(i - 5)
+		}
+	}
+
+	wg.Wait()
+}
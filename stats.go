@@ -0,0 +1,83 @@
+package gograph
+
+// GraphStats represents a snapshot of the basic metrics of a graph.
+type GraphStats struct {
+	// VertexCount is the number of vertices in the graph.
+	VertexCount int
+
+	// EdgeCount is the number of edges in the graph. In undirected graph,
+	// each edge is counted once, although it is stored in both directions.
+	EdgeCount int
+
+	// MaxDegree is the maximum degree of the vertices. In directed graph,
+	// the degree of a vertex is the sum of its in and out degrees.
+	MaxDegree int
+
+	// MinDegree is the minimum degree of the vertices.
+	MinDegree int
+
+	// AverageDegree is the average degree of the vertices.
+	AverageDegree float64
+
+	// Components is the number of connected components. In directed graph,
+	// it is the number of weakly connected components.
+	Components int
+}
+
+// Stats computes the basic metrics of the specified graph in a single pass
+// over its vertices and edges. All the fields of an empty graph are zero.
+func Stats[T comparable](g Graph[T]) GraphStats {
+	var stats GraphStats
+
+	vertices := g.GetAllVertices()
+	if len(vertices) == 0 {
+		return stats
+	}
+
+	stats.VertexCount = len(vertices)
+	stats.EdgeCount = int(g.Size())
+	if !g.IsDirected() {
+		stats.EdgeCount /= 2
+	}
+
+	// use a union-find structure to count the components
+	parents := make(map[T]T, len(vertices))
+	var find func(label T) T
+	find = func(label T) T {
+		if parents[label] != label {
+			parents[label] = find(parents[label])
+		}
+		return parents[label]
+	}
+
+	var totalDegree int
+	for i, v := range vertices {
+		parents[v.label] = v.label
+
+		// in undirected graph, each neighbor also increases the inDegree
+		degree := v.OutDegree()
+		if g.IsDirected() {
+			degree = v.Degree()
+		}
+
+		totalDegree += degree
+		if i == 0 || degree > stats.MaxDegree {
+			stats.MaxDegree = degree
+		}
+		if i == 0 || degree < stats.MinDegree {
+			stats.MinDegree = degree
+		}
+	}
+
+	stats.AverageDegree = float64(totalDegree) / float64(len(vertices))
+	stats.Components = len(vertices)
+	for _, edge := range g.AllEdges() {
+		source, dest := find(edge.source.label), find(edge.dest.label)
+		if source != dest {
+			parents[source] = dest
+			stats.Components--
+		}
+	}
+
+	return stats
+}
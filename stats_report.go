@@ -0,0 +1,139 @@
+package gograph
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// StatsReportOptionFunc represent an alias of function type that modifies
+// the options of StatsReport.
+type StatsReportOptionFunc func(options *statsReportOptions)
+
+type statsReportOptions struct {
+	skipDiameter bool
+}
+
+// WithoutDiameter skips the diameter in StatsReport, which is the most
+// expensive metric of the report, since it runs a BFS from each vertex.
+func WithoutDiameter() StatsReportOptionFunc {
+	return func(options *statsReportOptions) {
+		options.skipDiameter = true
+	}
+}
+
+// statsReport is the JSON document written by StatsReport.
+type statsReport struct {
+	Directed   bool         `json:"directed"`
+	Weighted   bool         `json:"weighted"`
+	Order      int          `json:"order"`
+	Size       int          `json:"size"`
+	Density    float64      `json:"density"`
+	Components int          `json:"components"`
+	Degree     degreeReport `json:"degree"`
+	Diameter   *int         `json:"diameter,omitempty"`
+}
+
+type degreeReport struct {
+	Min          int         `json:"min"`
+	Max          int         `json:"max"`
+	Average      float64     `json:"average"`
+	Distribution map[int]int `json:"distribution"`
+}
+
+// StatsReport computes a bundle of metrics of the graph and writes them to
+// the writer as a JSON object, for dashboards and monitoring. The report has
+// the following fields:
+//
+//   - directed, weighted: the type of the graph.
+//   - order, size: the number of vertices and edges, as in Stats.
+//   - density: the ratio of the edges to the maximum possible number of
+//     edges without self-loops, or 0 if the graph has less than 2 vertices.
+//   - components: the number of (weakly) connected components.
+//   - degree: the min, max and average degree, and the distribution, which
+//     maps each degree to the number of vertices with that degree.
+//   - diameter: the longest shortest path in hops. It is omitted if some
+//     vertex is not reachable from another one, or if the graph is empty.
+//
+// The diameter can be skipped with WithoutDiameter, since it takes
+// O(V*(V+E)) time, while the other metrics take O(V+E).
+func StatsReport[T comparable](g Graph[T], w io.Writer, options ...StatsReportOptionFunc) error {
+	var opts statsReportOptions
+	for _, option := range options {
+		option(&opts)
+	}
+
+	stats := Stats(g)
+	report := statsReport{
+		Directed:   g.IsDirected(),
+		Weighted:   g.IsWeighted(),
+		Order:      stats.VertexCount,
+		Size:       stats.EdgeCount,
+		Components: stats.Components,
+		Degree: degreeReport{
+			Min:          stats.MinDegree,
+			Max:          stats.MaxDegree,
+			Average:      stats.AverageDegree,
+			Distribution: make(map[int]int),
+		},
+	}
+
+	if n := float64(stats.VertexCount); n >= 2 {
+		report.Density = float64(stats.EdgeCount) / (n * (n - 1))
+		if !g.IsDirected() {
+			report.Density *= 2
+		}
+	}
+
+	for _, v := range g.GetAllVertices() {
+		// in undirected graph, each neighbor also increases the inDegree
+		degree := v.OutDegree()
+		if g.IsDirected() {
+			degree = v.Degree()
+		}
+
+		report.Degree.Distribution[degree]++
+	}
+
+	if !opts.skipDiameter {
+		report.Diameter = hopDiameter(g)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report)
+}
+
+// hopDiameter returns the maximum number of hops of the shortest paths
+// between all pairs of vertices, following the out-edges. It returns nil if
+// the graph is empty or some vertex is not reachable from another one.
+func hopDiameter[T comparable](g Graph[T]) *int {
+	vertices := g.GetAllVertices()
+	if len(vertices) == 0 {
+		return nil
+	}
+
+	var diameter int
+	for _, source := range vertices {
+		dist := map[T]int{source.label: 0}
+		queue := []*Vertex[T]{source}
+		for len(queue) > 0 {
+			curr := queue[0]
+			queue = queue[1:]
+
+			for _, neighbor := range curr.adjacent() {
+				if _, ok := dist[neighbor.label]; !ok {
+					dist[neighbor.label] = dist[curr.label] + 1
+					diameter = max(diameter, dist[neighbor.label])
+					queue = append(queue, neighbor)
+				}
+			}
+		}
+
+		if len(dist) != len(vertices) {
+			return nil
+		}
+	}
+
+	return &diameter
+}
@@ -0,0 +1,82 @@
+package gograph
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func decodeStatsReport(t *testing.T, g Graph[int], options ...StatsReportOptionFunc) map[string]any {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := StatsReport(g, &buf, options...); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	var report map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	return report
+}
+
+func TestStatsReport(t *testing.T) {
+	// 1 - 2 - 3
+	//     |
+	//     4
+	g := New[int]()
+	_, _ = g.AddEdge(NewVertex(1), NewVertex(2))
+	_, _ = g.AddEdge(NewVertex(2), NewVertex(3))
+	_, _ = g.AddEdge(NewVertex(2), NewVertex(4))
+
+	expected := map[string]any{
+		"directed":   false,
+		"weighted":   false,
+		"order":      4.0,
+		"size":       3.0,
+		"density":    0.5,
+		"components": 1.0,
+		"degree": map[string]any{
+			"min":          1.0,
+			"max":          3.0,
+			"average":      1.5,
+			"distribution": map[string]any{"1": 3.0, "3": 1.0},
+		},
+		"diameter": 2.0,
+	}
+
+	if report := decodeStatsReport(t, g); !reflect.DeepEqual(report, expected) {
+		t.Errorf(testErrMsgNotEqual, expected, report)
+	}
+
+	// the diameter can be skipped
+	delete(expected, "diameter")
+	if report := decodeStatsReport(t, g, WithoutDiameter()); !reflect.DeepEqual(report, expected) {
+		t.Errorf(testErrMsgNotEqual, expected, report)
+	}
+}
+
+func TestStatsReportWithoutDiameter(t *testing.T) {
+	// the vertices of a directed path can't reach the previous ones
+	g := New[int](Directed())
+	_, _ = g.AddEdge(NewVertex(1), NewVertex(2))
+	_, _ = g.AddEdge(NewVertex(2), NewVertex(3))
+
+	report := decodeStatsReport(t, g)
+	if _, ok := report["diameter"]; ok {
+		t.Errorf("Expected no diameter, but got %v", report["diameter"])
+	}
+
+	if report["density"] != 2.0/6.0 || report["components"] != 1.0 {
+		t.Errorf(testErrMsgNotEqual, []float64{2.0 / 6.0, 1}, []any{report["density"], report["components"]})
+	}
+
+	// an empty graph has zero metrics and no diameter
+	report = decodeStatsReport(t, New[int]())
+	if _, ok := report["diameter"]; ok || report["order"] != 0.0 || report["density"] != 0.0 {
+		t.Errorf("Expected an empty report, but got %v", report)
+	}
+}
@@ -0,0 +1,66 @@
+package gograph
+
+import "testing"
+
+func TestStats(t *testing.T) {
+	// an empty graph has zero stats
+	if stats := Stats(New[int]()); stats != (GraphStats{}) {
+		t.Errorf(testErrMsgNotEqual, GraphStats{}, stats)
+	}
+
+	// 1 - 2 - 3    4 - 5    6
+	//  \  |
+	//   \ |
+	//     7
+	g := New[int]()
+	vertices := make(map[int]*Vertex[int])
+	for i := 1; i <= 7; i++ {
+		vertices[i] = g.AddVertexByLabel(i)
+	}
+
+	_, _ = g.AddEdge(vertices[1], vertices[2])
+	_, _ = g.AddEdge(vertices[2], vertices[3])
+	_, _ = g.AddEdge(vertices[1], vertices[7])
+	_, _ = g.AddEdge(vertices[2], vertices[7])
+	_, _ = g.AddEdge(vertices[4], vertices[5])
+
+	expected := GraphStats{
+		VertexCount:   7,
+		EdgeCount:     5,
+		MaxDegree:     3,
+		MinDegree:     0,
+		AverageDegree: 10.0 / 7.0,
+		Components:    3,
+	}
+
+	if stats := Stats(g); stats != expected {
+		t.Errorf(testErrMsgNotEqual, expected, stats)
+	}
+}
+
+func TestStatsDirected(t *testing.T) {
+	// 1 -> 2 -> 3, 1 -> 3, 4 -> 3
+	g := New[int](Directed())
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+	v4 := g.AddVertexByLabel(4)
+
+	_, _ = g.AddEdge(v1, v2)
+	_, _ = g.AddEdge(v2, v3)
+	_, _ = g.AddEdge(v1, v3)
+	_, _ = g.AddEdge(v4, v3)
+
+	expected := GraphStats{
+		VertexCount:   4,
+		EdgeCount:     4,
+		MaxDegree:     3,
+		MinDegree:     1,
+		AverageDegree: 2,
+		Components:    1,
+	}
+
+	if stats := Stats(g); stats != expected {
+		t.Errorf(testErrMsgNotEqual, expected, stats)
+	}
+}
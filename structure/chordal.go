@@ -0,0 +1,75 @@
+package structure
+
+import (
+	"github.com/gavinhailey/gograph"
+)
+
+// IsChordal reports whether the graph is chordal, which means every cycle of
+// length four or more has a chord, an edge between two non-consecutive
+// vertices of the cycle. If the graph is chordal, it also returns a perfect
+// elimination ordering of its vertices, in which the neighbors of each vertex
+// that come after it form a clique.
+//
+// It uses the maximum cardinality search, which repeatedly visits the vertex
+// with the most visited neighbors. The reverse of the visit order is a
+// perfect elimination ordering if and only if the graph is chordal, so it
+// verifies the candidate ordering. The time complexity is O(V^2 + V*E).
+//
+// Chordality is defined on the underlying undirected graph, so the edge
+// directions and self-loops are ignored.
+func IsChordal[T comparable](g gograph.Graph[T]) (bool, []*gograph.Vertex[T], error) {
+	adjacency := undirectedAdjacency(g)
+	vertices := g.GetAllVertices()
+
+	// Maximum cardinality search
+	weights := make(map[T]int, len(vertices))
+	visited := make(map[T]bool, len(vertices))
+	order := make([]*gograph.Vertex[T], len(vertices))
+	for i := len(vertices) - 1; i >= 0; i-- {
+		var best *gograph.Vertex[T]
+		for _, v := range vertices {
+			if !visited[v.Label()] && (best == nil || weights[v.Label()] > weights[best.Label()]) {
+				best = v
+			}
+		}
+
+		visited[best.Label()] = true
+		order[i] = best
+		for neighbor := range adjacency[best.Label()] {
+			if !visited[neighbor] {
+				weights[neighbor]++
+			}
+		}
+	}
+
+	// Verify the ordering: for each vertex, its later neighbors except the
+	// earliest of them must be adjacent to the earliest one.
+	position := make(map[T]int, len(order))
+	for i, v := range order {
+		position[v.Label()] = i
+	}
+
+	for i, v := range order {
+		var (
+			later    []T
+			earliest T
+			first    = len(order)
+		)
+		for neighbor := range adjacency[v.Label()] {
+			if p := position[neighbor]; p > i {
+				later = append(later, neighbor)
+				if p < first {
+					earliest, first = neighbor, p
+				}
+			}
+		}
+
+		for _, neighbor := range later {
+			if neighbor != earliest && !adjacency[earliest][neighbor] {
+				return false, nil, nil
+			}
+		}
+	}
+
+	return true, order, nil
+}
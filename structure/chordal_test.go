@@ -0,0 +1,87 @@
+package structure
+
+import (
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func newUndirectedGraph(edges [][2]int) gograph.Graph[int] {
+	g := gograph.New[int]()
+	for _, e := range edges {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	return g
+}
+
+// isPerfectEliminationOrdering reports whether the later neighbors of each
+// vertex in the ordering form a clique.
+func isPerfectEliminationOrdering(g gograph.Graph[int], order []*gograph.Vertex[int]) bool {
+	position := make(map[int]int, len(order))
+	for i, v := range order {
+		position[v.Label()] = i
+	}
+
+	for i, v := range order {
+		var later []int
+		for _, neighbor := range v.Neighbors() {
+			if position[neighbor.Label()] > i {
+				later = append(later, neighbor.Label())
+			}
+		}
+
+		for a := range later {
+			for b := a + 1; b < len(later); b++ {
+				if !g.HasEdge(later[a], later[b]) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+func TestIsChordal(t *testing.T) {
+	// a hexagon triangulated by the diagonals from vertex 0
+	triangulated := newUndirectedGraph([][2]int{
+		{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 0},
+		{0, 2}, {0, 3}, {0, 4},
+	})
+
+	chordal, order, err := IsChordal(triangulated)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !chordal {
+		t.Fatal("expected the triangulated polygon to be chordal")
+	}
+
+	if len(order) != 6 || !isPerfectEliminationOrdering(triangulated, order) {
+		t.Errorf("expected a perfect elimination ordering, got %v", order)
+	}
+}
+
+func TestIsChordalNotChordal(t *testing.T) {
+	tests := map[string]gograph.Graph[int]{
+		"4-cycle": newUndirectedGraph([][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}}),
+		"hexagon with a chord": newUndirectedGraph([][2]int{
+			{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 0}, {0, 3},
+		}),
+	}
+
+	for name, g := range tests {
+		t.Run(name, func(t *testing.T) {
+			chordal, order, err := IsChordal(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if chordal || order != nil {
+				t.Errorf("expected the graph not to be chordal, got the ordering %v", order)
+			}
+		})
+	}
+}
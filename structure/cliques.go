@@ -0,0 +1,122 @@
+package structure
+
+import (
+	"github.com/gavinhailey/gograph"
+)
+
+// MaximalCliques returns all the maximal cliques of the graph, which are the
+// sets of pairwise adjacent vertices that can't be extended by another
+// vertex. It calls VisitMaximalCliques and collects its output.
+//
+// The number of maximal cliques may be exponential in the number of
+// vertices, so use VisitMaximalCliques to process them one by one or stop
+// early.
+//
+// It returns ErrNotUndirected if the graph is directed, like CountTriangles.
+func MaximalCliques[T comparable](g gograph.Graph[T]) ([][]*gograph.Vertex[T], error) {
+	var cliques [][]*gograph.Vertex[T]
+	err := VisitMaximalCliques(g, func(clique []*gograph.Vertex[T]) error {
+		cliques = append(cliques, clique)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cliques, nil
+}
+
+// VisitMaximalCliques calls the callback function on each maximal clique of
+// the graph. If the callback function returns an error, the enumeration is
+// stopped and the error is returned. The callback function owns the clique
+// slice it is called with.
+//
+// It uses the Bron-Kerbosch algorithm with pivoting, which at each step
+// picks the pivot vertex with the most candidate neighbors, and only
+// branches on the candidates that are not adjacent to it. The worst-case
+// time complexity is O(3^(V/3)), which is optimal since there may be as many
+// maximal cliques.
+//
+// Self-loops are ignored, and each isolated vertex is a maximal clique of
+// its own.
+//
+// It returns ErrNotUndirected if the graph is directed, like CountTriangles.
+func VisitMaximalCliques[T comparable](g gograph.Graph[T], f func(clique []*gograph.Vertex[T]) error) error {
+	if g.IsDirected() {
+		return ErrNotUndirected
+	}
+
+	adjacency := undirectedAdjacency(g)
+
+	candidates := make(map[T]bool, len(adjacency))
+	for label := range adjacency {
+		candidates[label] = true
+	}
+
+	var clique []T
+	var bronKerbosch func(candidates, excluded map[T]bool) error
+	bronKerbosch = func(candidates, excluded map[T]bool) error {
+		if len(candidates) == 0 {
+			if len(excluded) > 0 {
+				return nil
+			}
+
+			return f(g.GetAllVerticesByID(clique...))
+		}
+
+		var pivot T
+		most := -1
+		for _, set := range []map[T]bool{candidates, excluded} {
+			for label := range set {
+				var count int
+				for neighbor := range adjacency[label] {
+					if candidates[neighbor] {
+						count++
+					}
+				}
+
+				if count > most {
+					pivot, most = label, count
+				}
+			}
+		}
+
+		var branches []T
+		for label := range candidates {
+			if !adjacency[pivot][label] {
+				branches = append(branches, label)
+			}
+		}
+
+		for _, label := range branches {
+			nextCandidates := make(map[T]bool)
+			nextExcluded := make(map[T]bool)
+			for neighbor := range adjacency[label] {
+				if candidates[neighbor] {
+					nextCandidates[neighbor] = true
+				}
+
+				if excluded[neighbor] {
+					nextExcluded[neighbor] = true
+				}
+			}
+
+			clique = append(clique, label)
+			if err := bronKerbosch(nextCandidates, nextExcluded); err != nil {
+				return err
+			}
+			clique = clique[:len(clique)-1]
+
+			delete(candidates, label)
+			excluded[label] = true
+		}
+
+		return nil
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	return bronKerbosch(candidates, make(map[T]bool))
+}
@@ -0,0 +1,102 @@
+package structure
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// sortedCliques returns the sorted labels of each clique, in ascending order.
+func sortedCliques(cliques [][]*gograph.Vertex[int]) [][]int {
+	out := make([][]int, 0, len(cliques))
+	for _, clique := range cliques {
+		labels := make([]int, 0, len(clique))
+		for _, v := range clique {
+			labels = append(labels, v.Label())
+		}
+		sort.Ints(labels)
+		out = append(out, labels)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		for k := 0; k < len(out[i]) && k < len(out[j]); k++ {
+			if out[i][k] != out[j][k] {
+				return out[i][k] < out[j][k]
+			}
+		}
+		return len(out[i]) < len(out[j])
+	})
+
+	return out
+}
+
+func TestMaximalCliques(t *testing.T) {
+	// two triangles sharing the edge 2-3, a pendant edge and an isolated vertex
+	g := newUndirectedGraph([][2]int{{1, 2}, {1, 3}, {2, 3}, {2, 4}, {3, 4}, {4, 5}})
+	g.AddVertexByLabel(6)
+
+	cliques, err := MaximalCliques(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := [][]int{{1, 2, 3}, {2, 3, 4}, {4, 5}, {6}}
+	if got := sortedCliques(cliques); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected cliques %v, got %v", expected, got)
+	}
+}
+
+func TestMaximalCliquesComplete(t *testing.T) {
+	g := newUndirectedGraph([][2]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}})
+
+	cliques, err := MaximalCliques(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := [][]int{{1, 2, 3, 4}}
+	if got := sortedCliques(cliques); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected cliques %v, got %v", expected, got)
+	}
+
+	empty, err := MaximalCliques(gograph.New[int]())
+	if err != nil || len(empty) != 0 {
+		t.Errorf("Expected no cliques, got %v, %v", empty, err)
+	}
+}
+
+func TestMaximalCliquesDirected(t *testing.T) {
+	g := gograph.New[int](gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+
+	// directed graphs are rejected, like by CountTriangles
+	if _, err := MaximalCliques(g); !errors.Is(err, ErrNotUndirected) {
+		t.Errorf("Expected error %s, got %v", ErrNotUndirected, err)
+	}
+
+	if _, err := CountTriangles(g); !errors.Is(err, ErrNotUndirected) {
+		t.Errorf("Expected error %s, got %v", ErrNotUndirected, err)
+	}
+}
+
+func TestVisitMaximalCliquesEarlyExit(t *testing.T) {
+	g := newUndirectedGraph([][2]int{{1, 2}, {1, 3}, {2, 3}, {2, 4}, {3, 4}, {4, 5}})
+
+	errStop := errors.New("stop")
+	var visited int
+	err := VisitMaximalCliques(g, func(clique []*gograph.Vertex[int]) error {
+		visited++
+		return errStop
+	})
+
+	if !errors.Is(err, errStop) {
+		t.Errorf("Expected error %v, got %v", errStop, err)
+	}
+
+	if visited != 1 {
+		t.Errorf("Expected 1 visited clique, got %d", visited)
+	}
+}
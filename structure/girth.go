@@ -0,0 +1,109 @@
+package structure
+
+import (
+	"github.com/gavinhailey/gograph"
+)
+
+// Girth returns the length of the shortest cycle of the graph, or 0 if the
+// graph has no cycle. A self-loop is a cycle of length 1.
+//
+// In undirected graphs, it runs a BFS from each vertex, and each edge that
+// closes a cycle with the BFS tree, i.e. an edge to an already visited
+// vertex other than the parent, gives a cycle of length dist(u) + dist(w) + 1
+// through the root. The shortest of them over all roots is the girth. Since
+// the graphs have no parallel edges, an edge and its reverse direction don't
+// form a cycle.
+//
+// In directed graphs, the cycles follow the edge directions, so two opposite
+// edges between the same vertices form a cycle of length 2. It runs a BFS
+// from each vertex, and the shortest cycle through the root is closed by the
+// first visited vertex with an edge back to the root.
+//
+// The time complexity is O(V*(V+E)) in both cases.
+func Girth[T comparable](g gograph.Graph[T]) (int, error) {
+	for _, edge := range g.AllEdges() {
+		if edge.Source().Label() == edge.Destination().Label() {
+			return 1, nil
+		}
+	}
+
+	var girth int
+	for _, root := range g.GetAllVertices() {
+		var length int
+		if g.IsDirected() {
+			length = shortestDirectedCycle(root)
+		} else {
+			length = shortestUndirectedCycle(root)
+		}
+
+		if length > 0 && (girth == 0 || length < girth) {
+			girth = length
+		}
+	}
+
+	return girth, nil
+}
+
+// shortestDirectedCycle returns the length of the shortest directed cycle
+// through the root, or 0 if there is none.
+func shortestDirectedCycle[T comparable](root *gograph.Vertex[T]) int {
+	dist := map[T]int{root.Label(): 0}
+	queue := []*gograph.Vertex[T]{root}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+
+		for _, neighbor := range curr.Neighbors() {
+			if neighbor.Label() == root.Label() {
+				return dist[curr.Label()] + 1
+			}
+
+			if _, ok := dist[neighbor.Label()]; !ok {
+				dist[neighbor.Label()] = dist[curr.Label()] + 1
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	return 0
+}
+
+// shortestUndirectedCycle returns the length of the shortest cycle closed by
+// a non-tree edge of the BFS from the root, or 0 if there is none. The
+// minimum over all roots is the girth.
+func shortestUndirectedCycle[T comparable](root *gograph.Vertex[T]) int {
+	dist := map[T]int{root.Label(): 0}
+	parent := make(map[T]T)
+	queue := []*gograph.Vertex[T]{root}
+
+	var shortest int
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+
+		// no shorter cycle can be closed from here on
+		if shortest > 0 && 2*dist[curr.Label()] >= shortest {
+			break
+		}
+
+		for _, neighbor := range curr.Neighbors() {
+			d, visited := dist[neighbor.Label()]
+			if !visited {
+				dist[neighbor.Label()] = dist[curr.Label()] + 1
+				parent[neighbor.Label()] = curr.Label()
+				queue = append(queue, neighbor)
+				continue
+			}
+
+			if p, ok := parent[curr.Label()]; ok && p == neighbor.Label() {
+				continue
+			}
+
+			if length := dist[curr.Label()] + d + 1; shortest == 0 || length < shortest {
+				shortest = length
+			}
+		}
+	}
+
+	return shortest
+}
@@ -0,0 +1,66 @@
+package structure
+
+import (
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestGirth(t *testing.T) {
+	petersen := [][2]int{
+		{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 0},
+		{0, 5}, {1, 6}, {2, 7}, {3, 8}, {4, 9},
+		{5, 7}, {7, 9}, {9, 6}, {6, 8}, {8, 5},
+	}
+
+	tests := []struct {
+		name     string
+		edges    [][2]int
+		expected int
+	}{
+		{name: "empty", edges: nil, expected: 0},
+		{name: "tree", edges: [][2]int{{1, 2}, {1, 3}, {2, 4}, {2, 5}}, expected: 0},
+		{name: "triangle and square", edges: [][2]int{
+			{1, 2}, {2, 3}, {3, 4}, {4, 1}, {4, 5}, {5, 6}, {6, 4},
+		}, expected: 3},
+		{name: "hexagon", edges: [][2]int{{1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 6}, {6, 1}}, expected: 6},
+		{name: "Petersen", edges: petersen, expected: 5},
+		{name: "self-loop", edges: [][2]int{{1, 2}, {2, 2}}, expected: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Girth(newUndirectedGraph(test.edges))
+			if err != nil {
+				t.Fatalf("Expected no error, but got %s", err)
+			}
+
+			if got != test.expected {
+				t.Errorf("Expected girth %d, got %d", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestGirthDirected(t *testing.T) {
+	g := gograph.New[int](gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	_, _ = g.AddEdge(gograph.NewVertex(2), gograph.NewVertex(3))
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(3))
+
+	// the undirected triangle is not a directed cycle
+	if got, _ := Girth(g); got != 0 {
+		t.Errorf("Expected girth 0, got %d", got)
+	}
+
+	_, _ = g.AddEdge(gograph.NewVertex(3), gograph.NewVertex(4))
+	_, _ = g.AddEdge(gograph.NewVertex(4), gograph.NewVertex(1))
+	if got, _ := Girth(g); got != 3 {
+		t.Errorf("Expected girth 3, got %d", got)
+	}
+
+	_, _ = g.AddEdge(gograph.NewVertex(2), gograph.NewVertex(1))
+	if got, _ := Girth(g); got != 2 {
+		t.Errorf("Expected girth 2, got %d", got)
+	}
+}
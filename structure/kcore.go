@@ -0,0 +1,143 @@
+package structure
+
+import (
+	"github.com/gavinhailey/gograph"
+)
+
+// KCore returns the k-core of the graph, which is its maximal subgraph in
+// which every vertex has degree at least k. It is computed by repeatedly
+// removing the vertices with degree less than k, since removing a vertex
+// may lower the degrees of its neighbors below k, in O(V+E).
+//
+// The degrees are taken in the underlying undirected graph, so in directed
+// graphs the degree of a vertex is its number of distinct neighbors in both
+// directions, and self-loops are ignored.
+//
+// The returned graph is a copy with the same properties as the input graph,
+// which is not modified. It is empty if the graph has no k-core.
+func KCore[T comparable](g gograph.Graph[T], k int) (gograph.Graph[T], error) {
+	adjacency := undirectedAdjacency(g)
+
+	degree := make(map[T]int, len(adjacency))
+	var queue []T
+	for label, neighbors := range adjacency {
+		degree[label] = len(neighbors)
+		if degree[label] < k {
+			queue = append(queue, label)
+		}
+	}
+
+	removed := make(map[T]bool)
+	for len(queue) > 0 {
+		label := queue[0]
+		queue = queue[1:]
+		if removed[label] {
+			continue
+		}
+		removed[label] = true
+
+		for neighbor := range adjacency[label] {
+			if removed[neighbor] {
+				continue
+			}
+
+			degree[neighbor]--
+			if degree[neighbor] == k-1 {
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	core := gograph.Clone(g)
+	labels := make([]T, 0, len(removed))
+	for label := range removed {
+		labels = append(labels, label)
+	}
+
+	if err := core.RemoveVerticesByLabel(labels...); err != nil {
+		return nil, err
+	}
+
+	return core, nil
+}
+
+// CoreNumbers returns the core number of each vertex of the graph, which is
+// the largest k for which the vertex belongs to the k-core. Like KCore, it
+// works on the underlying undirected graph.
+//
+// It uses the Batagelj-Zaversnik algorithm, which sorts the vertices by
+// degree with a bucket sort, and then processes them in increasing order of
+// degree, decrementing the degree of the unprocessed neighbors of each one
+// and moving them to the lower bucket, in O(V+E).
+func CoreNumbers[T comparable](g gograph.Graph[T]) (map[T]int, error) {
+	adjacency := undirectedAdjacency(g)
+
+	labels := make([]T, 0, len(adjacency))
+	index := make(map[T]int, len(adjacency))
+	for label := range adjacency {
+		index[label] = len(labels)
+		labels = append(labels, label)
+	}
+
+	n := len(labels)
+	degree := make([]int, n)
+	var maxDegree int
+	for i, label := range labels {
+		degree[i] = len(adjacency[label])
+		maxDegree = max(maxDegree, degree[i])
+	}
+
+	// bin[d] is the start position of the vertices with degree d in vert,
+	// and pos[v] is the position of the vertex v in vert.
+	bin := make([]int, maxDegree+1)
+	for _, d := range degree {
+		bin[d]++
+	}
+
+	var start int
+	for d := range bin {
+		start, bin[d] = start+bin[d], start
+	}
+
+	pos := make([]int, n)
+	vert := make([]int, n)
+	for v, d := range degree {
+		pos[v] = bin[d]
+		vert[pos[v]] = v
+		bin[d]++
+	}
+
+	for d := maxDegree; d > 0; d-- {
+		bin[d] = bin[d-1]
+	}
+	bin[0] = 0
+
+	for i := 0; i < n; i++ {
+		v := vert[i]
+		for neighbor := range adjacency[labels[v]] {
+			u := index[neighbor]
+			if degree[u] <= degree[v] {
+				continue
+			}
+
+			// swap u with the first vertex of its bin, and shrink the bin
+			du, pu := degree[u], pos[u]
+			pw := bin[du]
+			w := vert[pw]
+			if u != w {
+				pos[u], pos[w] = pw, pu
+				vert[pu], vert[pw] = w, u
+			}
+
+			bin[du]++
+			degree[u]--
+		}
+	}
+
+	cores := make(map[T]int, n)
+	for v, label := range labels {
+		cores[label] = degree[v]
+	}
+
+	return cores, nil
+}
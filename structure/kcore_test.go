@@ -0,0 +1,101 @@
+package structure
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func sortedLabels(g gograph.Graph[int]) []int {
+	var labels []int
+	for _, v := range g.GetAllVertices() {
+		labels = append(labels, v.Label())
+	}
+
+	sort.Ints(labels)
+	return labels
+}
+
+func TestKCore(t *testing.T) {
+	// a square with a diagonal and a path hanging from it, plus a triangle
+	// whose third vertex is only connected through a pendant
+	g := newUndirectedGraph([][2]int{
+		{1, 2}, {2, 3}, {3, 4}, {4, 1}, {1, 3},
+		{4, 5}, {5, 6},
+		{7, 8}, {8, 9}, {9, 7}, {9, 10},
+	})
+
+	tests := []struct {
+		k        int
+		expected []int
+	}{
+		{k: 0, expected: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{k: 1, expected: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{k: 2, expected: []int{1, 2, 3, 4, 7, 8, 9}},
+		{k: 3, expected: nil},
+	}
+
+	for _, test := range tests {
+		core, err := KCore(g, test.k)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if got := sortedLabels(core); !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("Expected %d-core %v, got %v", test.k, test.expected, got)
+		}
+
+		for _, v := range core.GetAllVertices() {
+			if len(v.Neighbors()) < test.k {
+				t.Errorf("Expected degree of %d to be at least %d in the %d-core", v.Label(), test.k, test.k)
+			}
+		}
+	}
+
+	if g.Order() != 10 {
+		t.Error("Expected the input graph not to be modified")
+	}
+}
+
+func TestCoreNumbers(t *testing.T) {
+	// a K4, connected to a square, which has a pendant path and an isolated vertex
+	g := newUndirectedGraph([][2]int{
+		{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4},
+		{4, 5}, {5, 6}, {6, 7}, {7, 8}, {8, 5},
+		{8, 9}, {9, 10},
+	})
+	g.AddVertexByLabel(11)
+
+	cores, err := CoreNumbers(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := map[int]int{1: 3, 2: 3, 3: 3, 4: 3, 5: 2, 6: 2, 7: 2, 8: 2, 9: 1, 10: 1, 11: 0}
+	if !reflect.DeepEqual(cores, expected) {
+		t.Errorf("Expected core numbers %v, got %v", expected, cores)
+	}
+
+	// the k-core is made of the vertices with core number at least k
+	for k := 0; k <= 4; k++ {
+		core, _ := KCore(g, k)
+
+		var want []int
+		for label, c := range cores {
+			if c >= k {
+				want = append(want, label)
+			}
+		}
+		sort.Ints(want)
+
+		if got := sortedLabels(core); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %d-core %v, got %v", k, want, got)
+		}
+	}
+
+	if empty, _ := CoreNumbers(gograph.New[int]()); len(empty) != 0 {
+		t.Errorf("Expected no core numbers, got %v", empty)
+	}
+}
@@ -0,0 +1,200 @@
+package structure
+
+import (
+	"github.com/gavinhailey/gograph"
+)
+
+// maxKuratowskiSearchOrder is the maximum number of vertices of a reduced
+// component on which IsPlanar searches for K5 and K3,3 subgraphs.
+const maxKuratowskiSearchOrder = 64
+
+// IsPlanar reports whether the graph can be drawn in the plane without edge
+// crossings. Planarity is defined on the underlying undirected graph, so the
+// edge directions and self-loops are ignored.
+//
+// It is a practical check rather than a full planarity test such as
+// Boyer-Myrvold. The graph is first reduced without changing its planarity,
+// by repeatedly removing the vertices of degree at most one and smoothing
+// the vertices of degree two. Then each connected component of the reduced
+// graph is reported non-planar if:
+//   - it has more than 3V-6 edges, the bound of Euler's formula,
+//   - it has no triangle and more than 2V-4 edges, or
+//   - it has at most 64 vertices and contains K5 or K3,3 as a subgraph.
+//
+// A false result is always correct, but a true result may be wrong for
+// graphs whose Kuratowski subgraph is hidden behind subdivisions of
+// vertices of degree three or more, like the Petersen graph, or for large
+// components which are not searched for K5 and K3,3.
+func IsPlanar[T comparable](g gograph.Graph[T]) (bool, error) {
+	adjacency := undirectedAdjacency(g)
+	reduceForPlanarity(adjacency)
+
+	for _, component := range adjacencyComponents(adjacency) {
+		n := len(component)
+		if n < 5 {
+			continue
+		}
+
+		var m int
+		for _, v := range component {
+			m += len(adjacency[v])
+		}
+		m /= 2
+
+		if m > 3*n-6 {
+			return false, nil
+		}
+
+		if m > 2*n-4 && !hasTriangle(adjacency, component) {
+			return false, nil
+		}
+
+		if n <= maxKuratowskiSearchOrder && (hasK5(adjacency, component) || hasK33(adjacency, component)) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// reduceForPlanarity removes the vertices of degree at most one and
+// replaces each vertex of degree two by an edge between its neighbors,
+// until no such vertex remains. Both operations preserve planarity.
+func reduceForPlanarity[T comparable](adjacency map[T]map[T]bool) {
+	queue := make([]T, 0, len(adjacency))
+	for v := range adjacency {
+		queue = append(queue, v)
+	}
+
+	for len(queue) > 0 {
+		v := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+
+		neighbors, ok := adjacency[v]
+		if !ok || len(neighbors) > 2 {
+			continue
+		}
+
+		ends := make([]T, 0, 2)
+		for neighbor := range neighbors {
+			ends = append(ends, neighbor)
+			delete(adjacency[neighbor], v)
+			queue = append(queue, neighbor)
+		}
+		delete(adjacency, v)
+
+		if len(ends) == 2 {
+			adjacency[ends[0]][ends[1]] = true
+			adjacency[ends[1]][ends[0]] = true
+		}
+	}
+}
+
+// adjacencyComponents returns the vertices of each connected component of
+// the adjacency map.
+func adjacencyComponents[T comparable](adjacency map[T]map[T]bool) [][]T {
+	var components [][]T
+	visited := make(map[T]bool, len(adjacency))
+	for v := range adjacency {
+		if visited[v] {
+			continue
+		}
+
+		visited[v] = true
+		component := []T{v}
+		for i := 0; i < len(component); i++ {
+			for neighbor := range adjacency[component[i]] {
+				if !visited[neighbor] {
+					visited[neighbor] = true
+					component = append(component, neighbor)
+				}
+			}
+		}
+
+		components = append(components, component)
+	}
+
+	return components
+}
+
+// hasTriangle reports whether the component contains a cycle of length three.
+func hasTriangle[T comparable](adjacency map[T]map[T]bool, component []T) bool {
+	for _, u := range component {
+		for v := range adjacency[u] {
+			for w := range adjacency[v] {
+				if w != u && adjacency[u][w] {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// hasK5 reports whether the component contains five pairwise adjacent
+// vertices, by extending cliques among the vertices of degree four or more.
+func hasK5[T comparable](adjacency map[T]map[T]bool, component []T) bool {
+	var candidates []T
+	for _, v := range component {
+		if len(adjacency[v]) >= 4 {
+			candidates = append(candidates, v)
+		}
+	}
+
+	var extend func(clique []T, start int) bool
+	extend = func(clique []T, start int) bool {
+		if len(clique) == 5 {
+			return true
+		}
+
+	next:
+		for i := start; i < len(candidates); i++ {
+			for _, member := range clique {
+				if !adjacency[member][candidates[i]] {
+					continue next
+				}
+			}
+
+			if extend(append(clique, candidates[i]), i+1) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return extend(make([]T, 0, 5), 0)
+}
+
+// hasK33 reports whether the component contains K3,3, which means three
+// vertices with three common neighbors outside of them.
+func hasK33[T comparable](adjacency map[T]map[T]bool, component []T) bool {
+	var candidates []T
+	for _, v := range component {
+		if len(adjacency[v]) >= 3 {
+			candidates = append(candidates, v)
+		}
+	}
+
+	for i := range candidates {
+		for j := i + 1; j < len(candidates); j++ {
+			for k := j + 1; k < len(candidates); k++ {
+				a, b, c := candidates[i], candidates[j], candidates[k]
+
+				var common int
+				for v := range adjacency[a] {
+					if v != b && v != c && adjacency[b][v] && adjacency[c][v] {
+						common++
+					}
+				}
+
+				if common >= 3 {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,48 @@
+package structure
+
+import (
+	"testing"
+)
+
+func TestIsPlanar(t *testing.T) {
+	k5 := [][2]int{{1, 2}, {1, 3}, {1, 4}, {1, 5}, {2, 3}, {2, 4}, {2, 5}, {3, 4}, {3, 5}, {4, 5}}
+	k33 := [][2]int{{1, 4}, {1, 5}, {1, 6}, {2, 4}, {2, 5}, {2, 6}, {3, 4}, {3, 5}, {3, 6}}
+
+	// K3,3 with the edge 1-4 subdivided twice, and a pendant path
+	subdividedK33 := [][2]int{
+		{1, 7}, {7, 8}, {8, 4}, {1, 5}, {1, 6}, {2, 4}, {2, 5}, {2, 6}, {3, 4}, {3, 5}, {3, 6}, {6, 9}, {9, 10},
+	}
+
+	// K4 with a 3x3 grid attached to it
+	planar := [][2]int{
+		{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4},
+		{4, 5}, {5, 6}, {6, 7}, {5, 8}, {6, 9}, {7, 10}, {8, 9}, {9, 10}, {8, 11}, {9, 12}, {10, 13}, {11, 12}, {12, 13},
+	}
+
+	tests := []struct {
+		name     string
+		edges    [][2]int
+		expected bool
+	}{
+		{name: "empty", edges: nil, expected: true},
+		{name: "tree", edges: [][2]int{{1, 2}, {1, 3}, {2, 4}, {2, 5}, {3, 6}}, expected: true},
+		{name: "cycle", edges: [][2]int{{1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 6}, {6, 1}}, expected: true},
+		{name: "K4 and grid", edges: planar, expected: true},
+		{name: "K5", edges: k5, expected: false},
+		{name: "K3,3", edges: k33, expected: false},
+		{name: "subdivided K3,3", edges: subdividedK33, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := IsPlanar(newUndirectedGraph(test.edges))
+			if err != nil {
+				t.Fatalf("Expected no error, but got %s", err)
+			}
+
+			if got != test.expected {
+				t.Errorf("Expected IsPlanar to be %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package structure
+
+import (
+	"github.com/gavinhailey/gograph"
+)
+
+// ApproxTreewidth returns an upper bound on the treewidth of the graph,
+// which tells whether the algorithms based on tree decomposition are
+// feasible. Computing the exact treewidth is NP-hard, so it uses the
+// min-degree heuristic: it repeatedly eliminates a vertex of minimum degree
+// after connecting all of its neighbors to each other. The maximum degree of
+// the eliminated vertices is the width of the resulting tree decomposition,
+// which is not less than the exact treewidth.
+//
+// The treewidth is defined on the underlying undirected graph, so the edge
+// directions and self-loops are ignored. The treewidth of a forest is at most
+// 1, and the treewidth of an empty graph is zero.
+func ApproxTreewidth[T comparable](g gograph.Graph[T]) (int, error) {
+	adjacency := undirectedAdjacency(g)
+
+	var width int
+	for len(adjacency) > 0 {
+		var (
+			best      T
+			minDegree = -1
+		)
+		for label, neighbors := range adjacency {
+			if minDegree == -1 || len(neighbors) < minDegree {
+				best, minDegree = label, len(neighbors)
+			}
+		}
+
+		width = max(width, minDegree)
+
+		// Make the neighbors of the eliminated vertex a clique
+		neighbors := adjacency[best]
+		for u := range neighbors {
+			delete(adjacency[u], best)
+			for w := range neighbors {
+				if u != w {
+					adjacency[u][w] = true
+				}
+			}
+		}
+
+		delete(adjacency, best)
+	}
+
+	return width, nil
+}
+
+// undirectedAdjacency returns the adjacency sets of the underlying undirected
+// graph without self-loops.
+func undirectedAdjacency[T comparable](g gograph.Graph[T]) map[T]map[T]bool {
+	adjacency := make(map[T]map[T]bool)
+	for _, v := range g.GetAllVertices() {
+		adjacency[v.Label()] = make(map[T]bool)
+	}
+
+	for _, edge := range g.AllEdges() {
+		from, to := edge.Source().Label(), edge.Destination().Label()
+		if from == to {
+			continue
+		}
+
+		adjacency[from][to] = true
+		adjacency[to][from] = true
+	}
+
+	return adjacency
+}
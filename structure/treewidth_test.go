@@ -0,0 +1,50 @@
+package structure
+
+import (
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestApproxTreewidth(t *testing.T) {
+	tree := gograph.New[int]()
+	for _, e := range [][2]int{{1, 2}, {1, 3}, {2, 4}, {2, 5}, {3, 6}, {6, 7}} {
+		_, _ = tree.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	cycle := gograph.New[int](gograph.Directed())
+	for i := 0; i < 6; i++ {
+		_, _ = cycle.AddEdge(gograph.NewVertex(i), gograph.NewVertex((i+1)%6))
+	}
+
+	complete := gograph.New[int]()
+	for i := 0; i < 5; i++ {
+		for j := i + 1; j < 5; j++ {
+			_, _ = complete.AddEdge(gograph.NewVertex(i), gograph.NewVertex(j))
+		}
+	}
+
+	tests := []struct {
+		name     string
+		graph    gograph.Graph[int]
+		expected int
+	}{
+		{name: "empty", graph: gograph.New[int](), expected: 0},
+		{name: "tree", graph: tree, expected: 1},
+		{name: "cycle", graph: cycle, expected: 2},
+		{name: "complete", graph: complete, expected: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, err := ApproxTreewidth(tt.graph)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if width != tt.expected {
+				t.Errorf("expected treewidth %d, got %d", tt.expected, width)
+			}
+		})
+	}
+}
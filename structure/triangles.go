@@ -0,0 +1,72 @@
+package structure
+
+import (
+	"github.com/gavinhailey/gograph"
+	"github.com/gavinhailey/gograph/connectivity"
+)
+
+// ErrNotUndirected is returned when a function requires an undirected graph.
+var ErrNotUndirected = connectivity.ErrNotUndirected
+
+// CountTriangles returns the number of triangles of the undirected graph,
+// which are the sets of three pairwise adjacent vertices. Self-loops are
+// ignored.
+//
+// It orients each edge from the endpoint with the lower degree to the one
+// with the higher degree, and intersects the out-neighbors of both
+// endpoints of each edge, so each triangle is counted exactly once. The
+// time complexity is O(E*sqrt(E)).
+//
+// It returns ErrNotUndirected if the graph is directed.
+func CountTriangles[T comparable](g gograph.Graph[T]) (int, error) {
+	if g.IsDirected() {
+		return 0, ErrNotUndirected
+	}
+
+	adjacency := undirectedAdjacency(g)
+	vertices := g.GetAllVertices()
+
+	rank := make(map[T]int, len(vertices))
+	for i, v := range vertices {
+		rank[v.Label()] = i
+	}
+
+	// before reports whether a comes before b in the degree ordering
+	before := func(a, b T) bool {
+		if len(adjacency[a]) != len(adjacency[b]) {
+			return len(adjacency[a]) < len(adjacency[b])
+		}
+		return rank[a] < rank[b]
+	}
+
+	forward := make([][]int, len(vertices))
+	for _, v := range vertices {
+		for neighbor := range adjacency[v.Label()] {
+			if before(v.Label(), neighbor) {
+				forward[rank[v.Label()]] = append(forward[rank[v.Label()]], rank[neighbor])
+			}
+		}
+	}
+
+	var count int
+	marked := make([]bool, len(vertices))
+	for u := range forward {
+		for _, v := range forward[u] {
+			marked[v] = true
+		}
+
+		for _, v := range forward[u] {
+			for _, w := range forward[v] {
+				if marked[w] {
+					count++
+				}
+			}
+		}
+
+		for _, v := range forward[u] {
+			marked[v] = false
+		}
+	}
+
+	return count, nil
+}
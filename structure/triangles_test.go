@@ -0,0 +1,45 @@
+package structure
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestCountTriangles(t *testing.T) {
+	tests := []struct {
+		name     string
+		edges    [][2]int
+		expected int
+	}{
+		{name: "empty", edges: nil, expected: 0},
+		{name: "K4", edges: [][2]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}, expected: 4},
+		{name: "K5", edges: [][2]int{
+			{1, 2}, {1, 3}, {1, 4}, {1, 5}, {2, 3}, {2, 4}, {2, 5}, {3, 4}, {3, 5}, {4, 5},
+		}, expected: 10},
+		{name: "K3,3", edges: [][2]int{
+			{1, 4}, {1, 5}, {1, 6}, {2, 4}, {2, 5}, {2, 6}, {3, 4}, {3, 5}, {3, 6},
+		}, expected: 0},
+		{name: "two triangles and a self-loop", edges: [][2]int{
+			{1, 2}, {1, 3}, {2, 3}, {2, 4}, {3, 4}, {4, 5}, {5, 5},
+		}, expected: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := CountTriangles(newUndirectedGraph(test.edges))
+			if err != nil {
+				t.Fatalf("Expected no error, but got %s", err)
+			}
+
+			if got != test.expected {
+				t.Errorf("Expected %d triangles, got %d", test.expected, got)
+			}
+		})
+	}
+
+	if _, err := CountTriangles(gograph.New[int](gograph.Directed())); !errors.Is(err, ErrNotUndirected) {
+		t.Errorf("Expected error %v, got %v", ErrNotUndirected, err)
+	}
+}
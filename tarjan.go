@@ -0,0 +1,59 @@
+package gograph
+
+// stronglyConnectedComponents returns the strongly connected components of
+// the directed graph, found by Tarjan's algorithm in O(V+E). The components
+// are returned in reverse topological order, so each component comes after
+// all the components reachable from it.
+func stronglyConnectedComponents[T comparable](g Graph[T]) [][]*Vertex[T] {
+	var (
+		index      int
+		stack      []*Vertex[T]
+		indices    = make(map[T]int)
+		lowLinks   = make(map[T]int)
+		onStack    = make(map[T]bool)
+		components [][]*Vertex[T]
+		visit      func(v *Vertex[T])
+	)
+
+	visit = func(v *Vertex[T]) {
+		indices[v.label] = index
+		lowLinks[v.label] = index
+		index++
+		stack = append(stack, v)
+		onStack[v.label] = true
+
+		for _, neighbor := range v.adjacent() {
+			if _, ok := indices[neighbor.label]; !ok {
+				visit(neighbor)
+				lowLinks[v.label] = min(lowLinks[v.label], lowLinks[neighbor.label])
+			} else if onStack[neighbor.label] {
+				lowLinks[v.label] = min(lowLinks[v.label], indices[neighbor.label])
+			}
+		}
+
+		if lowLinks[v.label] != indices[v.label] {
+			return
+		}
+
+		var component []*Vertex[T]
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w.label] = false
+			component = append(component, w)
+			if w.label == v.label {
+				break
+			}
+		}
+
+		components = append(components, component)
+	}
+
+	for _, v := range g.GetAllVertices() {
+		if _, ok := indices[v.label]; !ok {
+			visit(v)
+		}
+	}
+
+	return components
+}
@@ -7,13 +7,17 @@ import (
 // breadthFirstIterator is an implementation of the Iterator interface
 // for traversing a graph using a breadth-first search (BFS) algorithm.
 type breadthFirstIterator[T comparable] struct {
-	graph        gograph.Graph[T] // the graph being traversed.
-	start        T                // the label of the starting vertex for the BFS traversal.
-	queue        []T              // a slice that represents the queue of vertices to visit in BFS traversal order.
-	visited      map[T]bool       // a map that keeps track of whether a vertex has been visited or not.
-	head         int              // the current head of the queue.
-	depth        map[T]int        // a map that tracks the depth of each vertex from the start vertex
-	currentDepth int              // the depth of the current vertex being visited
+	graph        gograph.Graph[T]  // the graph being traversed.
+	source       EdgeSource[T]     // the edge source being traversed, instead of the graph.
+	starts       []T               // the labels of the starting vertices for the BFS traversal.
+	queue        []T               // a slice that represents the queue of vertices to visit in BFS traversal order.
+	visited      map[T]bool        // a map that keeps track of whether a vertex has been visited or not.
+	head         int               // the current head of the queue.
+	depth        map[T]int         // a map that tracks the depth of each vertex from the nearest start vertex
+	currentDepth int               // the depth of the current vertex being visited
+	parent       map[T]T           // a map that tracks the vertex from which each vertex was discovered
+	less         func(a, b T) bool // an optional comparator that defines the order of enqueuing the neighbors
+	events       eventSink[T]      // the optional sink of the traversal events
 }
 
 // NewBreadthFirstIterator creates a new instance of breadthFirstIterator
@@ -27,19 +31,63 @@ func NewBreadthFirstIterator[T comparable](g gograph.Graph[T], start T) (Iterato
 	return newBreadthFirstIterator[T](g, start), nil
 }
 
-func newBreadthFirstIterator[T comparable](g gograph.Graph[T], start T) *breadthFirstIterator[T] {
-	depth := make(map[T]int)
-	depth[start] = 0
+// NewBreadthFirstIteratorOrdered creates a new instance of breadthFirstIterator
+// that enqueues the neighbors of each vertex in the order defined by the less
+// function, instead of their insertion order. It makes the traversal order
+// reproducible regardless of how the edges were added.
+func NewBreadthFirstIteratorOrdered[T comparable](
+	g gograph.Graph[T],
+	start T,
+	less func(a, b T) bool,
+) (Iterator[T], error) {
+	v := g.GetVertexByID(start)
+	if v == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
 
-	return &breadthFirstIterator[T]{
-		graph:        g,
-		start:        start,
-		queue:        []T{start},
-		visited:      map[T]bool{start: true},
-		head:         -1,
-		depth:        depth,
-		currentDepth: 0,
+	iter := newBreadthFirstIterator[T](g, start)
+	iter.less = less
+
+	return iter, nil
+}
+
+// NewBreadthFirstIteratorMulti creates a new instance of breadthFirstIterator
+// that starts from multiple vertices, which is a multi-source BFS. All the
+// start vertices are at depth zero and they are visited first in the given
+// order, so the depth of each vertex is its distance to the nearest start
+// vertex. The duplicate start vertices are ignored.
+//
+// It returns ErrNoStartVertices if the starts slice is empty, and
+// ErrVertexDoesNotExist if any of the start vertices doesn't exist.
+func NewBreadthFirstIteratorMulti[T comparable](g gograph.Graph[T], starts []T) (Iterator[T], error) {
+	if len(starts) == 0 {
+		return nil, ErrNoStartVertices
 	}
+
+	unique := make([]T, 0, len(starts))
+	seen := make(map[T]bool, len(starts))
+	for _, start := range starts {
+		if g.GetVertexByID(start) == nil {
+			return nil, gograph.ErrVertexDoesNotExist
+		}
+
+		if !seen[start] {
+			seen[start] = true
+			unique = append(unique, start)
+		}
+	}
+
+	return newBreadthFirstIterator[T](g, unique...), nil
+}
+
+func newBreadthFirstIterator[T comparable](g gograph.Graph[T], starts ...T) *breadthFirstIterator[T] {
+	iter := &breadthFirstIterator[T]{
+		graph:  g,
+		starts: starts,
+	}
+	iter.Reset()
+
+	return iter
 }
 
 // HasNext returns a boolean indicating whether there are more vertices
@@ -57,36 +105,59 @@ func (d *breadthFirstIterator[T]) Next() *gograph.Vertex[T] {
 		return nil
 	}
 
+	// the start vertices are discovered before the first vertex is visited
+	if d.head == -1 {
+		for _, start := range d.starts {
+			d.events.discover(d.vertex(start))
+		}
+	}
+
 	d.head++
 
 	// get the next vertex from the queue
 	currentLabel := d.queue[d.head]
-	currentNode := d.graph.GetVertexByID(currentLabel)
+	currentNode := d.vertex(currentLabel)
 
 	// Update current depth
 	d.currentDepth = d.depth[currentLabel]
 
 	// add unvisited neighbors to the queue
-	neighbors := currentNode.Neighbors()
-	for _, neighbor := range neighbors {
+	for _, neighbor := range d.neighbors(currentNode) {
 		if !d.visited[neighbor.Label()] {
 			d.visited[neighbor.Label()] = true
 			d.queue = append(d.queue, neighbor.Label())
 			// Set depth for this neighbor
 			d.depth[neighbor.Label()] = d.currentDepth + 1
+			d.parent[neighbor.Label()] = currentLabel
+
+			d.events.relax(d.graph, currentNode, neighbor)
+			d.events.discover(neighbor)
 		}
 	}
 
+	d.events.finish(currentNode)
+
 	return currentNode
 }
 
+// Peek returns the next vertex to be visited in the BFS traversal without
+// dequeuing it, so it doesn't change the depth tracking of the iterator.
+// If the HasNext is false, returns nil.
+func (d *breadthFirstIterator[T]) Peek() *gograph.Vertex[T] {
+	if !d.HasNext() {
+		return nil
+	}
+
+	return d.vertex(d.queue[d.head+1])
+}
+
 // GetCurrentDepth returns the depth of the vertex that was most recently returned by Next().
 // The depth is the number of edges in the shortest path from the start vertex.
 func (d *breadthFirstIterator[T]) GetCurrentDepth() int {
 	return d.currentDepth
 }
 
-// GetDepthOfVertex returns the depth of the specified vertex from the start vertex.
+// GetDepthOfVertex returns the depth of the specified vertex from the nearest start vertex.
 // If the vertex has not been visited yet or does not exist, returns -1.
 func (d *breadthFirstIterator[T]) GetDepthOfVertex(label T) int {
 	if depth, exists := d.depth[label]; exists {
@@ -95,6 +166,15 @@ func (d *breadthFirstIterator[T]) GetDepthOfVertex(label T) int {
 	return -1
 }
 
+// GetParent returns the label of the vertex from which the specified vertex
+// was first discovered, which is its parent in the BFS tree.
+// If the vertex is the start vertex, has not been discovered yet, or does
+// not exist, the returned boolean is false.
+func (d *breadthFirstIterator[T]) GetParent(label T) (T, bool) {
+	parent, exists := d.parent[label]
+	return parent, exists
+}
+
 // Iterate iterates through all the vertices in the BFS traversal order
 // and applies the given function to each vertex. If the function returns
 // an error, the iteration stops and the error is returned.
@@ -108,6 +188,21 @@ func (d *breadthFirstIterator[T]) Iterate(f func(v *gograph.Vertex[T]) error) er
 	return nil
 }
 
+// IterateN iterates over at most n of the remaining vertices and calls the
+// callback function on each of them. It returns the number of visited
+// vertices, and the iterator continues after them on the next call.
+func (d *breadthFirstIterator[T]) IterateN(n int, f func(v *gograph.Vertex[T]) error) (int, error) {
+	var visited int
+	for visited < n && d.HasNext() {
+		visited++
+		if err := f(d.Next()); err != nil {
+			return visited, err
+		}
+	}
+
+	return visited, nil
+}
+
 // IterateWithDepth iterates through all vertices in BFS order and provides both
 // the vertex and its depth to the callback function.
 func (d *breadthFirstIterator[T]) IterateWithDepth(f func(v *gograph.Vertex[T], depth int) error) error {
@@ -122,11 +217,100 @@ func (d *breadthFirstIterator[T]) IterateWithDepth(f func(v *gograph.Vertex[T],
 	return nil
 }
 
+// IterateWithParent iterates through all vertices in BFS order and provides both
+// the vertex and its parent in the BFS tree to the callback function. The parent
+// of the start vertex is nil.
+func (d *breadthFirstIterator[T]) IterateWithParent(f func(v, parent *gograph.Vertex[T]) error) error {
+	for d.HasNext() {
+		vertex := d.Next()
+
+		var parent *gograph.Vertex[T]
+		if label, ok := d.GetParent(vertex.Label()); ok {
+			parent = d.vertex(label)
+		}
+
+		if err := f(vertex, parent); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Path returns the vertices that have been dequeued so far, in the BFS
+// traversal order.
+func (d *breadthFirstIterator[T]) Path() []*gograph.Vertex[T] {
+	path := make([]*gograph.Vertex[T], 0, d.head+1)
+	for _, label := range d.queue[:d.head+1] {
+		path = append(path, d.vertex(label))
+	}
+
+	return path
+}
+
+// Clone returns an independent copy of the iterator with the same queue,
+// visited set, head, depth and parent state.
+func (d *breadthFirstIterator[T]) Clone() Iterator[T] {
+	return &breadthFirstIterator[T]{
+		graph:        d.graph,
+		source:       d.source,
+		starts:       d.starts,
+		queue:        append([]T(nil), d.queue...),
+		visited:      copyMap(d.visited),
+		head:         d.head,
+		depth:        copyMap(d.depth),
+		currentDepth: d.currentDepth,
+		parent:       copyMap(d.parent),
+		less:         d.less,
+		events:       d.events,
+	}
+}
+
+// SetEventSink sets the function that receives the traversal events, in
+// traversal order. A nil sink disables the events.
+func (d *breadthFirstIterator[T]) SetEventSink(sink func(TraversalEvent[T])) {
+	d.events.sink = sink
+}
+
+// vertex returns the vertex with the given label, from the graph, or created
+// from the label if the iterator reads an edge source.
+func (d *breadthFirstIterator[T]) vertex(label T) *gograph.Vertex[T] {
+	if d.source != nil {
+		return gograph.NewVertex(label)
+	}
+
+	return d.graph.GetVertexByID(label)
+}
+
+// neighbors returns the neighbors of the vertex in the order of enqueuing.
+func (d *breadthFirstIterator[T]) neighbors(v *gograph.Vertex[T]) []*gograph.Vertex[T] {
+	if d.source == nil {
+		if d.less != nil {
+			return v.NeighborsSorted(d.less)
+		}
+
+		return v.Neighbors()
+	}
+
+	labels := d.source.Neighbors(v.Label())
+	neighbors := make([]*gograph.Vertex[T], len(labels))
+	for i, label := range labels {
+		neighbors[i] = gograph.NewVertex(label)
+	}
+
+	return neighbors
+}
+
 // Reset resets the iterator by setting the initial state of the iterator.
 func (d *breadthFirstIterator[T]) Reset() {
-	d.queue = []T{d.start}
+	d.queue = append([]T(nil), d.starts...)
 	d.head = -1
-	d.visited = map[T]bool{d.start: true}
-	d.depth = map[T]int{d.start: 0}
+	d.visited = make(map[T]bool, len(d.starts))
+	d.depth = make(map[T]int, len(d.starts))
+	for _, start := range d.starts {
+		d.visited[start] = true
+		d.depth[start] = 0
+	}
 	d.currentDepth = 0
+	d.parent = make(map[T]T)
 }
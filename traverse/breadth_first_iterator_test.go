@@ -301,4 +301,266 @@ func TestBreadthFirstIterator(t *testing.T) {
 			t.Errorf("Expected error %v, got %v", expectedErr, err)
 		}
 	})
+
+	// Test the parent tracking functionality
+	t.Run("ParentTracking", func(t *testing.T) {
+		iter, err := NewBreadthFirstIterator(g, "A")
+		if err != nil {
+			t.Fatalf("Failed to create iterator: %v", err)
+		}
+
+		bfsIter, ok := iter.(*breadthFirstIterator[string])
+		if !ok {
+			t.Fatal("Failed to assert iterator as breadthFirstIterator")
+		}
+
+		// the example graph is undirected, so E is discovered from B
+		// before D is dequeued, and F is discovered from C.
+		expectedParents := map[string]string{
+			"B": "A",
+			"D": "A",
+			"C": "B",
+			"E": "B",
+			"F": "C",
+		}
+
+		visited := 0
+		err = bfsIter.IterateWithParent(func(v, parent *gograph.Vertex[string]) error {
+			visited++
+			if v.Label() == "A" {
+				if parent != nil {
+					t.Errorf("Expected nil parent for the start vertex, got %s", parent.Label())
+				}
+				return nil
+			}
+
+			if parent == nil {
+				t.Errorf("Expected parent %s for vertex %s, got nil", expectedParents[v.Label()], v.Label())
+			} else if parent.Label() != expectedParents[v.Label()] {
+				t.Errorf("Expected parent %s for vertex %s, got %s",
+					expectedParents[v.Label()], v.Label(), parent.Label())
+			}
+			return nil
+		})
+		if err != nil {
+			t.Errorf("IterateWithParent returned error: %v", err)
+		}
+
+		if visited != len(vertices) {
+			t.Errorf("Expected to visit %d vertices, but visited %d", len(vertices), visited)
+		}
+
+		for label, expectedParent := range expectedParents {
+			parent, ok := bfsIter.GetParent(label)
+			if !ok || parent != expectedParent {
+				t.Errorf("GetParent: Expected parent %s for vertex %s, but got %s", expectedParent, label, parent)
+			}
+		}
+
+		if _, ok := bfsIter.GetParent("A"); ok {
+			t.Error("Expected the start vertex to have no parent")
+		}
+
+		bfsIter.Reset()
+		if _, ok := bfsIter.GetParent("B"); ok {
+			t.Error("Expected parents to be cleared after reset")
+		}
+	})
+
+	// Test that Peek doesn't advance the depth tracking
+	t.Run("PeekDepth", func(t *testing.T) {
+		iter, err := NewBreadthFirstIterator(g, "A")
+		if err != nil {
+			t.Fatalf("Failed to create iterator: %v", err)
+		}
+
+		bfsIter, ok := iter.(*breadthFirstIterator[string])
+		if !ok {
+			t.Fatal("Failed to assert iterator as breadthFirstIterator")
+		}
+
+		_ = bfsIter.Next() // A
+		_ = bfsIter.Next() // B
+
+		peeked := bfsIter.Peek()
+		if peeked.Label() != "D" {
+			t.Errorf("Expected Peek to return D, got %s", peeked.Label())
+		}
+
+		if bfsIter.GetCurrentDepth() != 1 {
+			t.Errorf("Expected current depth 1 after Peek, got %d", bfsIter.GetCurrentDepth())
+		}
+
+		// peeking D must not discover its neighbors
+		if bfsIter.GetDepthOfVertex("F") != -1 {
+			t.Errorf("Expected F to be undiscovered after Peek, got depth %d", bfsIter.GetDepthOfVertex("F"))
+		}
+
+		if next := bfsIter.Next(); next.Label() != "D" {
+			t.Errorf("Expected Next to return D, got %s", next.Label())
+		}
+	})
+}
+
+func TestBreadthFirstIteratorOrdered(t *testing.T) {
+	// edges are added in reverse label order
+	//	A -> D, A -> C, A -> B, B -> F, B -> E
+	g := gograph.New[string](gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("D"))
+	_, _ = g.AddEdge(g.GetVertexByID("A"), gograph.NewVertex("C"))
+	_, _ = g.AddEdge(g.GetVertexByID("A"), gograph.NewVertex("B"))
+	_, _ = g.AddEdge(g.GetVertexByID("B"), gograph.NewVertex("F"))
+	_, _ = g.AddEdge(g.GetVertexByID("B"), gograph.NewVertex("E"))
+
+	_, err := NewBreadthFirstIteratorOrdered(g, "X", func(a, b string) bool { return a < b })
+	if !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expect %+v error, but got %+v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	iter, err := NewBreadthFirstIteratorOrdered(g, "A", func(a, b string) bool { return a < b })
+	if err != nil {
+		t.Fatalf("Expect NewBreadthFirstIteratorOrdered doesn't return error, but got %s", err)
+	}
+
+	expected := []string{"A", "B", "C", "D", "E", "F"}
+	for run := 0; run < 3; run++ {
+		var ordered []string
+		_ = iter.Iterate(func(v *gograph.Vertex[string]) error {
+			ordered = append(ordered, v.Label())
+			return nil
+		})
+
+		if !reflect.DeepEqual(expected, ordered) {
+			t.Errorf("run %d: expected order %v, but got %v", run, expected, ordered)
+		}
+
+		iter.Reset()
+	}
+}
+
+func TestBreadthFirstIteratorMulti(t *testing.T) {
+	// A -> B -> C -> F
+	//           ^
+	//           |
+	//      E -> D
+	g := gograph.New[string](gograph.Directed())
+	for _, e := range [][2]string{{"A", "B"}, {"B", "C"}, {"E", "D"}, {"D", "C"}, {"C", "F"}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	if _, err := NewBreadthFirstIteratorMulti(g, nil); !errors.Is(err, ErrNoStartVertices) {
+		t.Errorf("Expected error %v, got %v", ErrNoStartVertices, err)
+	}
+
+	if _, err := NewBreadthFirstIteratorMulti(g, []string{"A", "X"}); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %v, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	iter, err := NewBreadthFirstIteratorMulti(g, []string{"A", "E", "A"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	bfsIter, ok := iter.(*breadthFirstIterator[string])
+	if !ok {
+		t.Fatal("Failed to convert iterator to breadthFirstIterator")
+	}
+
+	expected := []struct {
+		label string
+		depth int
+	}{
+		{"A", 0}, {"E", 0}, {"B", 1}, {"D", 1}, {"C", 2}, {"F", 3},
+	}
+
+	for pass := 0; pass < 2; pass++ {
+		for _, want := range expected {
+			v := bfsIter.Next()
+			if v == nil || v.Label() != want.label {
+				t.Fatalf("Expected vertex %s, got %v", want.label, v)
+			}
+
+			if depth := bfsIter.GetCurrentDepth(); depth != want.depth {
+				t.Errorf("Expected depth of %s to be %d, got %d", want.label, want.depth, depth)
+			}
+		}
+
+		if bfsIter.HasNext() {
+			t.Error("Expected the iteration to be finished")
+		}
+
+		if depth := bfsIter.GetDepthOfVertex("F"); depth != 3 {
+			t.Errorf("Expected depth of F to be 3, got %d", depth)
+		}
+
+		if _, ok = bfsIter.GetParent("E"); ok {
+			t.Error("Expected start vertex E to have no parent")
+		}
+
+		bfsIter.Reset()
+	}
+}
+
+func TestBreadthFirstIterator_EventSink(t *testing.T) {
+	// the example graph
+	//	A -> B -> D
+	//	|
+	//	v
+	//	C -> D
+	g := gograph.New[string](gograph.Directed())
+	for _, e := range [][2]string{{"A", "B"}, {"A", "C"}, {"B", "D"}, {"C", "D"}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	iter, err := NewBreadthFirstIteratorOrdered(g, "A", func(a, b string) bool { return a < b })
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	emitter, ok := iter.(EventEmitter[string])
+	if !ok {
+		t.Fatal("Expected the iterator to implement EventEmitter")
+	}
+
+	var events []string
+	emitter.SetEventSink(func(e TraversalEvent[string]) {
+		switch e.Kind {
+		case EventRelax:
+			events = append(events, e.Kind.String()+" "+e.Edge.Source().Label()+"->"+e.Edge.Destination().Label())
+		default:
+			events = append(events, e.Kind.String()+" "+e.Vertex.Label())
+		}
+	})
+
+	expected := []string{
+		"discover A",
+		"relax A->B", "discover B",
+		"relax A->C", "discover C",
+		"finish A",
+		"relax B->D", "discover D",
+		"finish B",
+		"finish C",
+		"finish D",
+	}
+
+	for pass := 0; pass < 2; pass++ {
+		events = nil
+		if err = iter.Iterate(func(*gograph.Vertex[string]) error { return nil }); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(events, expected) {
+			t.Errorf("Expected events %v, got %v", expected, events)
+		}
+
+		iter.Reset()
+	}
+
+	emitter.SetEventSink(nil)
+	events = nil
+	_ = iter.Iterate(func(*gograph.Vertex[string]) error { return nil })
+
+	if len(events) != 0 {
+		t.Errorf("Expected no events without a sink, got %v", events)
+	}
 }
@@ -22,6 +22,9 @@ type closestFirstIterator[T comparable] struct {
 	visited  map[T]bool                   // a map that keeps track of whether a vertex has been visited or not.
 	pq       *util.VertexPriorityQueue[T] // a slice of util.VertexWithPriority that represents a min heap.
 	currDist float64                      // the current distance from the start node.
+	path     []*gograph.Vertex[T]         // the vertices that have been returned by Next, in order.
+	reached  map[T]bool                   // a map that keeps track of whether a vertex has been discovered or not.
+	events   eventSink[T]                 // the optional sink of the traversal events
 }
 
 // NewClosestFirstIterator creates a new instance of depthFirstIterator
@@ -42,6 +45,7 @@ func NewClosestFirstIterator[T comparable](graph gograph.Graph[T], start T) (Ite
 		visited:  make(map[T]bool),
 		pq:       pq,
 		currDist: 0,
+		reached:  map[T]bool{start: true},
 	}, nil
 }
 
@@ -71,7 +75,13 @@ func (c *closestFirstIterator[T]) Next() *gograph.Vertex[T] {
 	vp := c.pq.Pop()
 	c.currDist = vp.Priority()
 	currNode := vp.Vertex()
+
+	// the start vertex is discovered before it is visited
+	if len(c.path) == 0 {
+		c.events.discover(currNode)
+	}
 	c.visited[currNode.Label()] = true
+	c.path = append(c.path, currNode)
 
 	neighbors := currNode.Neighbors()
 	for _, neighbor := range neighbors {
@@ -79,12 +89,31 @@ func (c *closestFirstIterator[T]) Next() *gograph.Vertex[T] {
 		if !c.visited[neighbor.Label()] {
 			dist := c.currDist + edge.Weight()
 			c.pq.Push(util.NewVertexWithPriority(neighbor, dist))
+
+			c.events.relax(c.graph, currNode, neighbor)
+			if !c.reached[neighbor.Label()] {
+				c.reached[neighbor.Label()] = true
+				c.events.discover(neighbor)
+			}
 		}
 	}
 
+	c.events.finish(currNode)
+
 	return currNode
 }
 
+// Peek returns the next vertex to be visited in the closest-first traversal
+// without removing it from the priority queue. If the HasNext is false,
+// returns nil.
+func (c *closestFirstIterator[T]) Peek() *gograph.Vertex[T] {
+	if !c.HasNext() {
+		return nil
+	}
+
+	return c.pq.Peek().Vertex()
+}
+
 // Iterate iterates through the vertices in random order and applies
 // the given function to each vertex. If the function returns an error,
 // the iteration stops and the error is returned.
@@ -98,13 +127,57 @@ func (c *closestFirstIterator[T]) Iterate(f func(v *gograph.Vertex[T]) error) er
 	return nil
 }
 
+// IterateN iterates over at most n of the remaining vertices and calls the
+// callback function on each of them. It returns the number of visited
+// vertices, and the iterator continues after them on the next call.
+func (c *closestFirstIterator[T]) IterateN(n int, f func(v *gograph.Vertex[T]) error) (int, error) {
+	var visited int
+	for visited < n && c.HasNext() {
+		visited++
+		if err := f(c.Next()); err != nil {
+			return visited, err
+		}
+	}
+
+	return visited, nil
+}
+
+// Clone returns an independent copy of the iterator with the same priority
+// queue, visited set and current distance.
+func (c *closestFirstIterator[T]) Clone() Iterator[T] {
+	return &closestFirstIterator[T]{
+		graph:    c.graph,
+		start:    c.start,
+		visited:  copyMap(c.visited),
+		pq:       c.pq.Clone(),
+		currDist: c.currDist,
+		path:     append([]*gograph.Vertex[T](nil), c.path...),
+		reached:  copyMap(c.reached),
+		events:   c.events,
+	}
+}
+
+// SetEventSink sets the function that receives the traversal events, in
+// traversal order. A nil sink disables the events.
+func (c *closestFirstIterator[T]) SetEventSink(sink func(TraversalEvent[T])) {
+	c.events.sink = sink
+}
+
 // Reset resets the iterator by setting the initial state of the iterator.
 // There is no guarantee that the reset method works as expected, if
 // the start vertex being removed.
 func (c *closestFirstIterator[T]) Reset() {
 	c.visited = make(map[T]bool)
 	c.currDist = 0
+	c.path = nil
+	c.reached = map[T]bool{c.start: true}
 
 	c.pq = util.NewVertexPriorityQueue[T]()
 	c.pq.Push(util.NewVertexWithPriority(c.graph.GetVertexByID(c.start), 0))
 }
+
+// Path returns the vertices that have been returned by Next so far,
+// in the order of their distances from the start vertex.
+func (c *closestFirstIterator[T]) Path() []*gograph.Vertex[T] {
+	return append([]*gograph.Vertex[T]{}, c.path...)
+}
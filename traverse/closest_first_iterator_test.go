@@ -139,3 +139,37 @@ func TestClosestFirstIterator_Iterate(t *testing.T) {
 		t.Errorf("Expect %+v error, but got %+v", expectedErr, err)
 	}
 }
+
+func TestClosestFirstIterator_FilteredView(t *testing.T) {
+	g := initClosestFirstIteratorTestGraph()
+
+	// hide the edge B -> C and the vertex D, so C is only reachable
+	// through the heavy edge A -> C
+	view := gograph.FilteredView(g,
+		func(e *gograph.Edge[string]) bool {
+			return e.Source().Label() != "B" || e.Destination().Label() != "C"
+		},
+		func(v *gograph.Vertex[string]) bool { return v.Label() != "D" },
+	)
+
+	it, err := NewClosestFirstIterator(view, "A")
+	if err != nil {
+		t.Fatalf("Expect NewClosestFirstIterator doesn't return error, but got %s", err)
+	}
+
+	var visited []string
+	for it.HasNext() {
+		visited = append(visited, it.Next().Label())
+	}
+
+	expected := []string{"A", "B", "C"}
+	if len(visited) != len(expected) {
+		t.Fatalf("Expected %v, but got %v", expected, visited)
+	}
+
+	for i := range expected {
+		if visited[i] != expected[i] {
+			t.Errorf("Expected %v, but got %v", expected, visited)
+		}
+	}
+}
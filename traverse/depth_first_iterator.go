@@ -7,10 +7,19 @@ import (
 // depthFirstIterator is an implementation of the Iterator interface
 // for traversing a graph using a depth-first search (DFS) algorithm.
 type depthFirstIterator[T comparable] struct {
-	graph   gograph.Graph[T] // the graph being traversed.
-	start   T                // the label of the starting vertex for the DFS traversal.
-	stack   []T              // a slice that represents the stack of vertices to visit in DFS traversal order.
-	visited map[T]bool       // a map that keeps track of whether a vertex has been visited or not.
+	graph   gograph.Graph[T]     // the graph being traversed.
+	start   T                    // the label of the starting vertex for the DFS traversal.
+	stack   []stackEntry[T]      // a slice that represents the stack of vertices to visit in DFS traversal order.
+	visited map[T]bool           // a map that keeps track of whether a vertex has been visited or not.
+	path    []*gograph.Vertex[T] // the vertices that have been returned by Next, in order.
+	events  eventSink[T]         // the optional sink of the traversal events
+}
+
+// stackEntry is an entry of the DFS stack. A visited vertex leaves a finish
+// marker below its neighbors, which is popped once they are all finished.
+type stackEntry[T comparable] struct {
+	label  T
+	finish bool
 }
 
 // NewDepthFirstIterator creates a new instance of depthFirstIterator
@@ -28,7 +37,7 @@ func newDepthFirstIterator[T comparable](g gograph.Graph[T], start T) *depthFirs
 	return &depthFirstIterator[T]{
 		graph:   g,
 		start:   start,
-		stack:   []T{start},
+		stack:   []stackEntry[T]{{label: start}},
 		visited: map[T]bool{start: true},
 	}
 }
@@ -48,23 +57,57 @@ func (d *depthFirstIterator[T]) Next() *gograph.Vertex[T] {
 		return nil
 	}
 
-	// get the next vertex from the queue
-	label := d.stack[len(d.stack)-1]
+	// get the next vertex from the stack
+	label := d.stack[len(d.stack)-1].label
 	d.stack = d.stack[:len(d.stack)-1]
 	currentNode := d.graph.GetVertexByID(label)
 
-	// add unvisited neighbors to the queue
+	// the start vertex is discovered before it is visited
+	if len(d.path) == 0 {
+		d.events.discover(currentNode)
+	}
+	d.path = append(d.path, currentNode)
+
+	// the vertex is finished after the unvisited neighbors, which are pushed
+	// above its marker
+	d.stack = append(d.stack, stackEntry[T]{label: label, finish: true})
 	neighbors := currentNode.Neighbors()
 	for _, neighbor := range neighbors {
 		if !d.visited[neighbor.Label()] {
-			d.stack = append(d.stack, neighbor.Label())
+			d.stack = append(d.stack, stackEntry[T]{label: neighbor.Label()})
 			d.visited[neighbor.Label()] = true
+
+			d.events.relax(d.graph, currentNode, neighbor)
+			d.events.discover(neighbor)
 		}
 	}
 
+	d.popFinished()
+
 	return currentNode
 }
 
+// popFinished pops the finish markers from the top of the stack, whose
+// vertices have no more neighbors to visit, and emits their finish events.
+// So the top of the stack is always a vertex to visit.
+func (d *depthFirstIterator[T]) popFinished() {
+	for len(d.stack) > 0 && d.stack[len(d.stack)-1].finish {
+		label := d.stack[len(d.stack)-1].label
+		d.stack = d.stack[:len(d.stack)-1]
+		d.events.finish(d.graph.GetVertexByID(label))
+	}
+}
+
+// Peek returns the next vertex to be visited in the DFS traversal without
+// popping it from the stack. If the HasNext is false, returns nil.
+func (d *depthFirstIterator[T]) Peek() *gograph.Vertex[T] {
+	if !d.HasNext() {
+		return nil
+	}
+
+	return d.graph.GetVertexByID(d.stack[len(d.stack)-1].label)
+}
+
 // Iterate iterates through all the vertices in the DFS traversal order
 // and applies the given function to each vertex. If the function returns
 // an error, the iteration stops and the error is returned.
@@ -78,8 +121,49 @@ func (d *depthFirstIterator[T]) Iterate(f func(v *gograph.Vertex[T]) error) erro
 	return nil
 }
 
+// IterateN iterates over at most n of the remaining vertices and calls the
+// callback function on each of them. It returns the number of visited
+// vertices, and the iterator continues after them on the next call.
+func (d *depthFirstIterator[T]) IterateN(n int, f func(v *gograph.Vertex[T]) error) (int, error) {
+	var visited int
+	for visited < n && d.HasNext() {
+		visited++
+		if err := f(d.Next()); err != nil {
+			return visited, err
+		}
+	}
+
+	return visited, nil
+}
+
+// Clone returns an independent copy of the iterator with the same stack
+// and visited set.
+func (d *depthFirstIterator[T]) Clone() Iterator[T] {
+	return &depthFirstIterator[T]{
+		graph:   d.graph,
+		start:   d.start,
+		stack:   append([]stackEntry[T](nil), d.stack...),
+		visited: copyMap(d.visited),
+		path:    append([]*gograph.Vertex[T](nil), d.path...),
+		events:  d.events,
+	}
+}
+
+// SetEventSink sets the function that receives the traversal events, in
+// traversal order. A nil sink disables the events.
+func (d *depthFirstIterator[T]) SetEventSink(sink func(TraversalEvent[T])) {
+	d.events.sink = sink
+}
+
 // Reset resets the iterator by setting the initial state of the iterator.
 func (d *depthFirstIterator[T]) Reset() {
-	d.stack = []T{d.start}
+	d.stack = []stackEntry[T]{{label: d.start}}
 	d.visited = map[T]bool{d.start: true}
+	d.path = nil
+}
+
+// Path returns the vertices that have been returned by Next so far,
+// in the DFS traversal order.
+func (d *depthFirstIterator[T]) Path() []*gograph.Vertex[T] {
+	return append([]*gograph.Vertex[T]{}, d.path...)
 }
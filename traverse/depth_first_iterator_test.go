@@ -116,3 +116,89 @@ func TestDepthFirstIterator(t *testing.T) {
 		t.Errorf("Expect %+v error, but got %+v", expectedErr, err)
 	}
 }
+
+func TestDepthFirstIterator_EventSink(t *testing.T) {
+	// the example graph
+	//	A -> B -> D
+	//	|
+	//	v
+	//	C -> D
+	g := gograph.New[string](gograph.Directed())
+	for _, e := range [][2]string{{"A", "B"}, {"A", "C"}, {"B", "D"}, {"C", "D"}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	iter, err := NewDepthFirstIterator[string](g, "A")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	emitter, ok := iter.(EventEmitter[string])
+	if !ok {
+		t.Fatal("Expected the iterator to implement EventEmitter")
+	}
+
+	var events []string
+	emitter.SetEventSink(func(e TraversalEvent[string]) {
+		switch e.Kind {
+		case EventRelax:
+			events = append(events, e.Kind.String()+" "+e.Edge.Source().Label()+"->"+e.Edge.Destination().Label())
+		default:
+			events = append(events, e.Kind.String()+" "+e.Vertex.Label())
+		}
+	})
+
+	// the vertices are finished in post-order: a vertex is finished after
+	// all the vertices discovered from it
+	expected := []string{
+		"discover A",
+		"relax A->B", "discover B",
+		"relax A->C", "discover C",
+		"relax C->D", "discover D",
+		"finish D",
+		"finish C",
+		"finish B",
+		"finish A",
+	}
+
+	for pass := 0; pass < 2; pass++ {
+		events = nil
+		if err = iter.Iterate(func(*gograph.Vertex[string]) error { return nil }); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(events, expected) {
+			t.Errorf("Expected events %v, got %v", expected, events)
+		}
+
+		iter.Reset()
+	}
+}
+
+func TestDepthFirstIterator_FinishAfterIterate(t *testing.T) {
+	g := gograph.New[string](gograph.Directed())
+	for _, e := range [][2]string{{"A", "B"}, {"B", "C"}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	iter, _ := NewDepthFirstIterator[string](g, "A")
+
+	var finished []string
+	iter.(EventEmitter[string]).SetEventSink(func(e TraversalEvent[string]) {
+		if e.Kind == EventFinish {
+			finished = append(finished, e.Vertex.Label())
+		}
+	})
+
+	// the finish markers never remain on the top of the stack
+	for iter.HasNext() {
+		if iter.Peek() == nil || iter.Next() == nil {
+			t.Fatal("Expected a vertex while HasNext is true")
+		}
+	}
+
+	expected := []string{"C", "B", "A"}
+	if !reflect.DeepEqual(finished, expected) {
+		t.Errorf("Expected finish order %v, got %v", expected, finished)
+	}
+}
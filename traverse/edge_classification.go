@@ -0,0 +1,105 @@
+package traverse
+
+import (
+	"github.com/gavinhailey/gograph"
+)
+
+// EdgeType represents the class of an edge in a depth-first search tree.
+type EdgeType int
+
+const (
+	// TreeEdge is an edge that discovers a new vertex in the DFS.
+	TreeEdge EdgeType = iota
+
+	// BackEdge is an edge that connects a vertex to one of its ancestors
+	// in the DFS tree, including self-loops. Back edges indicate cycles.
+	BackEdge
+
+	// ForwardEdge is a non-tree edge that connects a vertex to one of its
+	// descendants in the DFS tree.
+	ForwardEdge
+
+	// CrossEdge is an edge that connects two vertices that are neither
+	// ancestor nor descendant of each other.
+	CrossEdge
+)
+
+// String returns the name of the edge type.
+func (e EdgeType) String() string {
+	switch e {
+	case TreeEdge:
+		return "tree"
+	case BackEdge:
+		return "back"
+	case ForwardEdge:
+		return "forward"
+	case CrossEdge:
+		return "cross"
+	default:
+		return "unknown"
+	}
+}
+
+// dfsFrame is a frame of the iterative DFS stack. It keeps the vertex and
+// the index of the next neighbor to be explored.
+type dfsFrame[T comparable] struct {
+	vertex    *gograph.Vertex[T]
+	neighbors []*gograph.Vertex[T]
+	next      int
+}
+
+// ClassifyEdges performs a depth-first search from the start vertex and
+// classifies each edge that is reachable from it as a tree, back, forward,
+// or cross edge, based on the discovery and finish times of the vertices.
+// The neighbors are explored in their insertion order.
+//
+// It returns ErrNotDirected if the graph is undirected, and
+// gograph.ErrVertexDoesNotExist if the start vertex doesn't exist.
+func ClassifyEdges[T comparable](g gograph.Graph[T], start T) (map[*gograph.Edge[T]]EdgeType, error) {
+	if !g.IsDirected() {
+		return nil, ErrNotDirected
+	}
+
+	startVertex := g.GetVertexByID(start)
+	if startVertex == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	var clock int
+	discovery := map[T]int{start: clock}
+	finished := make(map[T]bool)
+	classes := make(map[*gograph.Edge[T]]EdgeType)
+
+	stack := []*dfsFrame[T]{{vertex: startVertex, neighbors: startVertex.Neighbors()}}
+	for len(stack) > 0 {
+		frame := stack[len(stack)-1]
+		if frame.next == len(frame.neighbors) {
+			// all the neighbors are explored, finish the vertex
+			finished[frame.vertex.Label()] = true
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		u := frame.vertex
+		v := g.GetVertexByID(frame.neighbors[frame.next].Label())
+		frame.next++
+
+		edge := g.GetEdge(u, v)
+		disc, discovered := discovery[v.Label()]
+		switch {
+		case !discovered:
+			classes[edge] = TreeEdge
+			clock++
+			discovery[v.Label()] = clock
+			stack = append(stack, &dfsFrame[T]{vertex: v, neighbors: v.Neighbors()})
+		case !finished[v.Label()]:
+			classes[edge] = BackEdge
+		case discovery[u.Label()] < disc:
+			classes[edge] = ForwardEdge
+		default:
+			classes[edge] = CrossEdge
+		}
+	}
+
+	return classes, nil
+}
@@ -0,0 +1,72 @@
+package traverse
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestClassifyEdges(t *testing.T) {
+	// the DFS from A visits A, B, C, D and then E
+	//	A -> B -> C -> D
+	//	A -> C          (forward)
+	//	D -> B          (back)
+	//	A -> E -> D     (E -> D is cross)
+	//	E -> E          (back, self-loop)
+	g := gograph.New[string](gograph.Directed())
+	for _, label := range []string{"A", "B", "C", "D", "E", "F"} {
+		g.AddVertexByLabel(label)
+	}
+
+	edges := []struct {
+		from, to string
+		expected EdgeType
+	}{
+		{"A", "B", TreeEdge},
+		{"B", "C", TreeEdge},
+		{"C", "D", TreeEdge},
+		{"A", "C", ForwardEdge},
+		{"D", "B", BackEdge},
+		{"A", "E", TreeEdge},
+		{"E", "D", CrossEdge},
+		{"E", "E", BackEdge},
+	}
+
+	for _, e := range edges {
+		_, err := g.AddEdge(g.GetVertexByID(e.from), g.GetVertexByID(e.to))
+		if err != nil {
+			t.Fatalf("Failed to add edge %s -> %s: %v", e.from, e.to, err)
+		}
+	}
+
+	// F is not reachable from A
+	_, _ = g.AddEdge(g.GetVertexByID("F"), g.GetVertexByID("A"))
+
+	classes, err := ClassifyEdges(g, "A")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if len(classes) != len(edges) {
+		t.Errorf("Expected %d classified edges, but got %d", len(edges), len(classes))
+	}
+
+	for _, e := range edges {
+		edge := g.GetEdge(g.GetVertexByID(e.from), g.GetVertexByID(e.to))
+		if classes[edge] != e.expected {
+			t.Errorf("Expected edge %s -> %s to be %s, but got %s", e.from, e.to, e.expected, classes[edge])
+		}
+	}
+
+	_, err = ClassifyEdges(g, "X")
+	if !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expect %+v error, but got %+v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	// the error is the same sentinel as the one of gograph
+	_, err = ClassifyEdges(gograph.New[string](), "A")
+	if !errors.Is(err, ErrNotDirected) || !errors.Is(err, gograph.ErrNotDirected) {
+		t.Errorf("Expect %+v error, but got %+v", ErrNotDirected, err)
+	}
+}
@@ -0,0 +1,49 @@
+package traverse
+
+import (
+	"github.com/gavinhailey/gograph"
+)
+
+// EdgeSource provides the adjacency of a graph lazily, one vertex at a time,
+// so a traversal doesn't need the whole graph in memory. It lets the graph
+// be streamed from a disk, a database or a remote service, or be generated
+// on the fly.
+//
+// An implementation that can fail, like a database backed one, should
+// return no neighbors for the failed vertex and report the error through
+// its own API.
+type EdgeSource[T comparable] interface {
+	// HasVertex reports whether the vertex with the given label exists.
+	HasVertex(label T) bool
+
+	// Neighbors returns the labels of the vertices that the edges of the
+	// given vertex lead to. For a directed graph, these are the
+	// out-neighbors. The order of the labels is the order of the traversal.
+	Neighbors(label T) []T
+}
+
+// NewBreadthFirstIteratorFromSource creates a new instance of
+// breadthFirstIterator that reads the adjacency from the edge source instead
+// of an in-memory graph. It visits the vertices in the same order as the
+// iterator of NewBreadthFirstIterator on the equivalent graph, and it only
+// keeps the labels of the discovered vertices in memory.
+//
+// The returned vertices are created from the labels, and they aren't part of
+// any graph, so their Neighbors are empty. Likewise, the edges of the relax
+// events are created from their end vertices, and they don't have weights.
+//
+// It returns gograph.ErrVertexDoesNotExist if the start vertex doesn't exist
+// in the source.
+func NewBreadthFirstIteratorFromSource[T comparable](src EdgeSource[T], start T) (Iterator[T], error) {
+	if !src.HasVertex(start) {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	iter := &breadthFirstIterator[T]{
+		source: src,
+		starts: []T{start},
+	}
+	iter.Reset()
+
+	return iter, nil
+}
@@ -0,0 +1,101 @@
+package traverse
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// mapEdgeSource is an in-memory EdgeSource that counts the neighbor lookups.
+type mapEdgeSource struct {
+	adjacency map[string][]string
+	lookups   int
+}
+
+func (m *mapEdgeSource) HasVertex(label string) bool {
+	_, ok := m.adjacency[label]
+	return ok
+}
+
+func (m *mapEdgeSource) Neighbors(label string) []string {
+	m.lookups++
+	return m.adjacency[label]
+}
+
+func TestNewBreadthFirstIteratorFromSource(t *testing.T) {
+	// the example graph
+	//	A -> B -> C
+	//	|    |    |
+	//	v    v    v
+	//	D -> E -> F
+	g := gograph.New[string](gograph.Directed())
+	for _, e := range [][2]string{
+		{"A", "B"}, {"A", "D"}, {"B", "C"}, {"B", "E"}, {"C", "F"}, {"D", "E"}, {"E", "F"},
+	} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	src := &mapEdgeSource{adjacency: make(map[string][]string)}
+	for _, v := range g.GetAllVertices() {
+		src.adjacency[v.Label()] = nil
+		for _, neighbor := range v.Neighbors() {
+			src.adjacency[v.Label()] = append(src.adjacency[v.Label()], neighbor.Label())
+		}
+	}
+
+	if _, err := NewBreadthFirstIteratorFromSource[string](src, "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %v, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	for _, start := range []string{"A", "B", "D", "F"} {
+		graphIter, err := NewBreadthFirstIterator(g, start)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		sourceIter, err := NewBreadthFirstIteratorFromSource[string](src, start)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expected := collectLabels(t, graphIter)
+		if actual := collectLabels(t, sourceIter); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("Expected BFS order %v from %s, got %v", expected, start, actual)
+		}
+
+		var path []string
+		for _, v := range sourceIter.Path() {
+			path = append(path, v.Label())
+		}
+
+		if !reflect.DeepEqual(path, expected) {
+			t.Errorf("Expected path %v from %s, got %v", expected, start, path)
+		}
+	}
+
+	// each vertex is read from the source once per traversal
+	src.lookups = 0
+	iter, _ := NewBreadthFirstIteratorFromSource[string](src, "A")
+	_ = iter.Iterate(func(*gograph.Vertex[string]) error { return nil })
+
+	if src.lookups != len(src.adjacency) {
+		t.Errorf("Expected %d neighbor lookups, got %d", len(src.adjacency), src.lookups)
+	}
+}
+
+func collectLabels(t *testing.T, iter Iterator[string]) []string {
+	t.Helper()
+
+	var labels []string
+	err := iter.Iterate(func(v *gograph.Vertex[string]) error {
+		labels = append(labels, v.Label())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	return labels
+}
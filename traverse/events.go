@@ -0,0 +1,92 @@
+package traverse
+
+import (
+	"github.com/gavinhailey/gograph"
+)
+
+// EventKind is the kind of a traversal event.
+type EventKind int
+
+const (
+	// EventDiscover is emitted when a vertex is reached for the first time.
+	EventDiscover EventKind = iota
+
+	// EventRelax is emitted when an edge is used to reach a vertex that
+	// hasn't been visited yet.
+	EventRelax
+
+	// EventFinish is emitted when all the neighbors of a vertex have been
+	// examined. In depth-first traversal, it is emitted once all the vertices
+	// discovered from the vertex are finished, so it is in post-order.
+	EventFinish
+)
+
+// String returns the name of the event kind.
+func (k EventKind) String() string {
+	switch k {
+	case EventDiscover:
+		return "discover"
+	case EventRelax:
+		return "relax"
+	case EventFinish:
+		return "finish"
+	default:
+		return "unknown"
+	}
+}
+
+// TraversalEvent is a step of a traversal, emitted to the event sink of an
+// iterator. The Vertex is set for the discover and finish events, and the
+// Edge is set for the relax events.
+type TraversalEvent[T comparable] struct {
+	Kind   EventKind
+	Vertex *gograph.Vertex[T]
+	Edge   *gograph.Edge[T]
+}
+
+// EventEmitter is implemented by the iterators that can emit their
+// traversal events, which are the BFS, DFS and closest-first iterators.
+// Use a type assertion on the Iterator to access it:
+//
+//	if emitter, ok := iter.(traverse.EventEmitter[string]); ok {
+//		emitter.SetEventSink(func(e traverse.TraversalEvent[string]) { ... })
+//	}
+type EventEmitter[T comparable] interface {
+	// SetEventSink sets the function that receives the traversal events,
+	// synchronously and in traversal order, while Next is called. A nil
+	// sink disables the events. The sink is kept by Reset and Clone.
+	SetEventSink(sink func(TraversalEvent[T]))
+}
+
+// eventSink holds the optional event sink of an iterator.
+type eventSink[T comparable] struct {
+	sink func(TraversalEvent[T])
+}
+
+func (e *eventSink[T]) discover(v *gograph.Vertex[T]) {
+	if e.sink != nil {
+		e.sink(TraversalEvent[T]{Kind: EventDiscover, Vertex: v})
+	}
+}
+
+// relax looks up the edge only if there is a sink, so the iterators
+// without a sink don't pay for it. Without a graph, the edge is created
+// from its end vertices.
+func (e *eventSink[T]) relax(g gograph.Graph[T], from, to *gograph.Vertex[T]) {
+	if e.sink == nil {
+		return
+	}
+
+	edge := gograph.NewEdge(from, to)
+	if g != nil {
+		edge = g.GetEdge(from, to)
+	}
+
+	e.sink(TraversalEvent[T]{Kind: EventRelax, Edge: edge})
+}
+
+func (e *eventSink[T]) finish(v *gograph.Vertex[T]) {
+	if e.sink != nil {
+		e.sink(TraversalEvent[T]{Kind: EventFinish, Vertex: v})
+	}
+}
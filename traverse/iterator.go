@@ -1,9 +1,20 @@
 package traverse
 
 import (
+	"errors"
+
 	"github.com/gavinhailey/gograph"
 )
 
+var (
+	ErrNotDirected = gograph.ErrNotDirected
+	ErrNotWeighted = gograph.ErrNotWeighted
+
+	// ErrNoStartVertices is returned when an iterator requires at least
+	// one start vertex, but none is given.
+	ErrNoStartVertices = errors.New("no start vertices")
+)
+
 // Iterator represents a general purpose iterator for iterating over
 // a sequence of graph's vertices. It provides methods for checking if
 // there are more elements to be iterated over, getting the next element,
@@ -20,15 +31,63 @@ type Iterator[T comparable] interface {
 	// the iterator to the next element.
 	Next() *gograph.Vertex[T]
 
+	// Peek returns the next element in the sequence being iterated over
+	// without advancing the iterator, so the following call to Next returns
+	// the same element. If there are no more elements, it returns nil.
+	Peek() *gograph.Vertex[T]
+
 	// Iterate iterates over all elements in the sequence and calls the
 	// provided callback function on each element. The callback function
 	// takes a single argument of type *Vertex, representing the current
 	// element being iterated over. It returns an error value, which is
 	// returned by the Iterate method. If the callback function returns
 	// an error, iteration is stopped and the error is returned.
+	//
+	// Iterate continues from the current state of the iterator, so it
+	// doesn't visit anything if the iterator is already exhausted. Use
+	// IterateAll to iterate over the whole sequence regardless of the state.
 	Iterate(func(v *gograph.Vertex[T]) error) error
 
+	// IterateN works like Iterate, but it stops after calling the callback
+	// function on at most n elements, and returns the number of elements
+	// it has been called on. The iterator is left positioned after the last
+	// visited element, so the next call continues from there. It lets the
+	// caller process a large sequence in chunks.
+	IterateN(n int, f func(v *gograph.Vertex[T]) error) (int, error)
+
+	// Clone returns an independent copy of the iterator in its current
+	// state, so advancing one of them doesn't affect the other one. Both
+	// iterators still traverse the same graph.
+	Clone() Iterator[T]
+
+	// Path returns the vertices that have been returned by Next so far, in
+	// the order they were visited. It lets the caller inspect the progress
+	// of a partial iteration. The returned slice is a copy, and it is empty
+	// after Reset.
+	Path() []*gograph.Vertex[T]
+
 	// Reset  resets the iterator to its initial state, allowing the
 	// sequence to be iterated over again from the beginning.
 	Reset()
 }
+
+// IterateAll resets the iterator, iterates over all elements in the sequence
+// by calling the Iterate method, and resets the iterator again. So it always
+// visits the whole sequence, and the iterator is ready for another pass
+// afterwards, even if the callback function returns an error.
+func IterateAll[T comparable](iter Iterator[T], f func(v *gograph.Vertex[T]) error) error {
+	iter.Reset()
+	defer iter.Reset()
+
+	return iter.Iterate(f)
+}
+
+// copyMap returns a shallow copy of the input map.
+func copyMap[K comparable, V any](in map[K]V) map[K]V {
+	out := make(map[K]V, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+
+	return out
+}
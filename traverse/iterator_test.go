@@ -0,0 +1,261 @@
+package traverse
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// initIteratorTestGraph creates the following weighted directed acyclic
+// graph, which is supported by all the iterators.
+//
+//	A -> B -> C
+//	|    |    |
+//	v    v    v
+//	D -> E -> F
+func initIteratorTestGraph() gograph.Graph[string] {
+	g := gograph.New[string](gograph.Acyclic(), gograph.Weighted())
+
+	vertices := map[string]*gograph.Vertex[string]{
+		"A": g.AddVertexByLabel("A"),
+		"B": g.AddVertexByLabel("B"),
+		"C": g.AddVertexByLabel("C"),
+		"D": g.AddVertexByLabel("D"),
+		"E": g.AddVertexByLabel("E"),
+		"F": g.AddVertexByLabel("F"),
+	}
+
+	_, _ = g.AddEdge(vertices["A"], vertices["B"], gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vertices["A"], vertices["D"], gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vertices["B"], vertices["C"], gograph.WithEdgeWeight(3))
+	_, _ = g.AddEdge(vertices["B"], vertices["E"], gograph.WithEdgeWeight(4))
+	_, _ = g.AddEdge(vertices["C"], vertices["F"], gograph.WithEdgeWeight(5))
+	_, _ = g.AddEdge(vertices["D"], vertices["E"], gograph.WithEdgeWeight(6))
+	_, _ = g.AddEdge(vertices["E"], vertices["F"], gograph.WithEdgeWeight(7))
+
+	return g
+}
+
+// initIterators creates an instance of each iterator over the specified graph.
+func initIterators(t *testing.T, g gograph.Graph[string]) map[string]Iterator[string] {
+	t.Helper()
+
+	iterators := make(map[string]Iterator[string])
+	constructors := map[string]func() (Iterator[string], error){
+		"BFS":          func() (Iterator[string], error) { return NewBreadthFirstIterator(g, "A") },
+		"DFS":          func() (Iterator[string], error) { return NewDepthFirstIterator(g, "A") },
+		"Topological":  func() (Iterator[string], error) { return NewTopologicalIterator(g) },
+		"ClosestFirst": func() (Iterator[string], error) { return NewClosestFirstIterator(g, "A") },
+		"RandomWalk":   func() (Iterator[string], error) { return NewRandomWalkIterator(g, "A", 5) },
+	}
+
+	for name, constructor := range constructors {
+		iter, err := constructor()
+		if err != nil {
+			t.Fatalf("Failed to create %s iterator: %v", name, err)
+		}
+		iterators[name] = iter
+	}
+
+	return iterators
+}
+
+func TestIterator_Peek(t *testing.T) {
+	g := initIteratorTestGraph()
+
+	for name, iter := range initIterators(t, g) {
+		t.Run(name, func(t *testing.T) {
+			for iter.HasNext() {
+				peeked := iter.Peek()
+				if again := iter.Peek(); again != peeked {
+					t.Errorf("Expected Peek to be idempotent, got %v and %v", peeked, again)
+				}
+
+				next := iter.Next()
+				if next == nil || peeked == nil || next.Label() != peeked.Label() {
+					t.Errorf("Expected Next to return the peeked vertex %v, got %v", peeked, next)
+				}
+			}
+
+			if v := iter.Peek(); v != nil {
+				t.Errorf("Expected Peek to return nil after exhaustion, got %v", v)
+			}
+		})
+	}
+}
+
+func TestIterator_Clone(t *testing.T) {
+	g := initIteratorTestGraph()
+
+	for name, iter := range initIterators(t, g) {
+		t.Run(name, func(t *testing.T) {
+			// advance the original partway
+			iter.Next()
+			iter.Next()
+
+			position := iter.Peek()
+			clone := iter.Clone()
+
+			// exhaust the clone
+			var cloned []string
+			for clone.HasNext() {
+				cloned = append(cloned, clone.Next().Label())
+			}
+
+			if !iter.HasNext() {
+				t.Fatal("Expected original iterator to have more vertices")
+			}
+
+			if iter.Peek() != position {
+				t.Errorf("Expected original position %v, got %v", position, iter.Peek())
+			}
+
+			var remaining []string
+			for iter.HasNext() {
+				remaining = append(remaining, iter.Next().Label())
+			}
+
+			// only the deterministic iterators must produce the same sequence
+			if name != "RandomWalk" && !reflect.DeepEqual(cloned, remaining) {
+				t.Errorf("Expected clone to visit %v, got %v", remaining, cloned)
+			}
+
+			if len(cloned) != len(remaining) {
+				t.Errorf("Expected clone to visit %d vertices, got %d", len(remaining), len(cloned))
+			}
+		})
+	}
+}
+
+func TestIterateAll(t *testing.T) {
+	g := initIteratorTestGraph()
+
+	for name, iter := range initIterators(t, g) {
+		t.Run(name, func(t *testing.T) {
+			expected := 6
+			if name == "RandomWalk" {
+				// the walk starts at 'A' and stops at the sink 'F' or after 5 steps
+				expected = -1
+			}
+
+			var counts []int
+			for pass := 0; pass < 2; pass++ {
+				var count int
+				err := IterateAll(iter, func(v *gograph.Vertex[string]) error {
+					count++
+					return nil
+				})
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+
+				if !iter.HasNext() {
+					t.Errorf("Expected the iterator to be reset after pass %d", pass+1)
+				}
+
+				counts = append(counts, count)
+			}
+
+			if expected != -1 && (counts[0] != expected || counts[1] != expected) {
+				t.Errorf("Expected both passes to visit %d vertices, got %v", expected, counts)
+			}
+
+			if counts[0] == 0 || counts[1] == 0 {
+				t.Errorf("Expected both passes to visit vertices, got %v", counts)
+			}
+		})
+	}
+}
+
+func TestIterator_Path(t *testing.T) {
+	g := initIteratorTestGraph()
+
+	for name, iter := range initIterators(t, g) {
+		t.Run(name, func(t *testing.T) {
+			if len(iter.Path()) != 0 {
+				t.Errorf("Expected empty path before iteration, got %v", iter.Path())
+			}
+
+			var visited []string
+			for i := 0; i < 3 && iter.HasNext(); i++ {
+				visited = append(visited, iter.Next().Label())
+			}
+
+			var path []string
+			for _, v := range iter.Path() {
+				path = append(path, v.Label())
+			}
+
+			if !reflect.DeepEqual(path, visited) {
+				t.Errorf("Expected path %v, got %v", visited, path)
+			}
+
+			// advancing a clone doesn't change the path of the original one
+			clone := iter.Clone()
+			clone.Next()
+			if len(iter.Path()) != len(visited) {
+				t.Errorf("Expected path of length %d, got %d", len(visited), len(iter.Path()))
+			}
+
+			iter.Reset()
+			if len(iter.Path()) != 0 {
+				t.Errorf("Expected empty path after reset, got %v", iter.Path())
+			}
+		})
+	}
+}
+
+func TestIterator_IterateN(t *testing.T) {
+	g := initIteratorTestGraph()
+
+	for name, iter := range initIterators(t, g) {
+		t.Run(name, func(t *testing.T) {
+			if visited, err := iter.IterateN(0, nil); visited != 0 || err != nil {
+				t.Errorf("Expected IterateN(0) to visit nothing, got %d, %v", visited, err)
+			}
+
+			counts := make(map[string]int)
+			var total, calls int
+			for iter.HasNext() {
+				visited, err := iter.IterateN(2, func(v *gograph.Vertex[string]) error {
+					counts[v.Label()]++
+					return nil
+				})
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+
+				if visited < 1 || visited > 2 {
+					t.Errorf("Expected each chunk to visit 1 or 2 vertices, got %d", visited)
+				}
+
+				total += visited
+				calls++
+			}
+
+			if visited, _ := iter.IterateN(2, nil); visited != 0 {
+				t.Errorf("Expected exhausted iterator to visit nothing, got %d", visited)
+			}
+
+			if total != len(iter.Path()) || calls != (total+1)/2 {
+				t.Errorf("Expected %d vertices in %d chunks, got %d in %d", len(iter.Path()), (total+1)/2, total, calls)
+			}
+
+			// the walk may revisit vertices
+			if name == "RandomWalk" {
+				return
+			}
+
+			if len(counts) != 6 {
+				t.Errorf("Expected all 6 vertices to be visited, got %v", counts)
+			}
+
+			for label, count := range counts {
+				if count != 1 {
+					t.Errorf("Expected %s to be visited once, got %d", label, count)
+				}
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package traverse
 
 import (
 	"crypto/rand"
+	"math"
 	"math/big"
+	mrand "math/rand"
 
 	"github.com/gavinhailey/gograph"
 )
@@ -24,26 +26,74 @@ import (
 // connected by heavier edges are more likely to be visited during the
 // traversal.
 type randomWalkIterator[T comparable] struct {
-	graph       gograph.Graph[T]   // the graph that being traversed.
-	start       T                  // the label of starting point of the traversal.
-	current     *gograph.Vertex[T] // the latest node that has been returned by the iterator.
-	steps       int                // the maximum number of steps to be taken during the traversal.
-	currentStep int                // the step counter.
+	graph       gograph.Graph[T]     // the graph that being traversed.
+	start       T                    // the label of starting point of the traversal.
+	current     *gograph.Vertex[T]   // the latest node that has been returned by the iterator.
+	steps       int                  // the maximum number of steps to be taken during the traversal.
+	currentStep int                  // the step counter.
+	peeked      *gograph.Vertex[T]   // the next vertex that has been chosen by Peek, but not returned by Next yet.
+	rng         *mrand.Rand          // the source of randomness of the weighted walks, that makes the walk reproducible if seeded.
+	path        []*gograph.Vertex[T] // the vertices that have been returned by Next, in order.
 }
 
 // NewRandomWalkIterator creates a new instance of randomWalkIterator
-// and returns it as the Iterator interface.
+// and returns it as the Iterator interface. In a weighted graph, the next
+// neighbor is chosen like NewWeightedRandomWalkIterator with a nil rng.
 func NewRandomWalkIterator[T comparable](graph gograph.Graph[T], start T, steps int) (Iterator[T], error) {
 	v := graph.GetVertexByID(start)
 	if v == nil {
 		return nil, gograph.ErrVertexDoesNotExist
 	}
 
+	it := &randomWalkIterator[T]{
+		graph:   graph,
+		start:   start,
+		current: v,
+		steps:   steps,
+	}
+	if graph.IsWeighted() {
+		it.rng = newSeededRand()
+	}
+
+	return it, nil
+}
+
+// NewWeightedRandomWalkIterator creates a new instance of randomWalkIterator
+// that chooses the next neighbor with a probability proportional to the
+// weight of the outgoing edge, and returns it as the Iterator interface.
+//
+// The random choices are made by the specified rng, so a seeded rng makes
+// the walk reproducible. If rng is nil, it uses an rng seeded from
+// crypto/rand. If all the outgoing edges of a vertex have a zero or
+// negative weight, the next neighbor is chosen uniformly among them.
+//
+// If the graph is not weighted, returns ErrNotWeighted. If the start node
+// doesn't exist, returns error.
+func NewWeightedRandomWalkIterator[T comparable](
+	graph gograph.Graph[T],
+	start T,
+	steps int,
+	rng *mrand.Rand,
+) (Iterator[T], error) {
+	if !graph.IsWeighted() {
+		return nil, ErrNotWeighted
+	}
+
+	v := graph.GetVertexByID(start)
+	if v == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	if rng == nil {
+		rng = newSeededRand()
+	}
+
 	return &randomWalkIterator[T]{
 		graph:   graph,
 		start:   start,
 		current: v,
 		steps:   steps,
+		rng:     rng,
 	}, nil
 }
 
@@ -56,7 +106,8 @@ func (r *randomWalkIterator[T]) HasNext() bool {
 }
 
 // Next returns the next vertex to be visited in the random walk traversal.
-// It chooses one of the neighbors randomly and returns it.
+// It chooses one of the neighbors randomly and returns it. If Peek has been
+// called before, it returns the vertex that Peek has chosen.
 //
 // If the HasNext is false, returns nil.
 func (r *randomWalkIterator[T]) Next() *gograph.Vertex[T] {
@@ -64,23 +115,46 @@ func (r *randomWalkIterator[T]) Next() *gograph.Vertex[T] {
 		return nil
 	}
 
-	if r.currentStep == 0 {
-		r.currentStep++
-		return r.current
+	next := r.Peek()
+	r.peeked = nil
+	r.currentStep++
+	r.current = next
+	if next != nil {
+		r.path = append(r.path, next)
 	}
 
-	r.currentStep++
-	neighbors := r.current.Neighbors()
+	return r.current
+}
 
-	if r.graph.IsWeighted() {
-		r.current = r.randomVertex(r.current)
-		return r.current
+// Peek chooses the next vertex to be visited in the random walk traversal
+// without advancing the iterator. The choice is kept, so the following call
+// to Next returns the same vertex.
+//
+// If the HasNext is false, returns nil.
+func (r *randomWalkIterator[T]) Peek() *gograph.Vertex[T] {
+	if !r.HasNext() {
+		return nil
 	}
 
+	if r.peeked != nil {
+		return r.peeked
+	}
+
+	if r.currentStep == 0 {
+		r.peeked = r.current
+		return r.peeked
+	}
+
+	if r.rng != nil {
+		r.peeked = r.weightedRandomVertex(r.current)
+		return r.peeked
+	}
+
+	neighbors := r.current.Neighbors()
 	i, _ := rand.Int(rand.Reader, big.NewInt(int64(len(neighbors))))
-	r.current = neighbors[i.Int64()]
+	r.peeked = neighbors[i.Int64()]
 
-	return r.current
+	return r.peeked
 }
 
 // Iterate iterates through the vertices in random order and applies
@@ -96,34 +170,82 @@ func (r *randomWalkIterator[T]) Iterate(f func(v *gograph.Vertex[T]) error) erro
 	return nil
 }
 
+// IterateN iterates over at most n of the remaining vertices and calls the
+// callback function on each of them. It returns the number of visited
+// vertices, and the iterator continues after them on the next call.
+func (r *randomWalkIterator[T]) IterateN(n int, f func(v *gograph.Vertex[T]) error) (int, error) {
+	var visited int
+	for visited < n && r.HasNext() {
+		visited++
+		if err := f(r.Next()); err != nil {
+			return visited, err
+		}
+	}
+
+	return visited, nil
+}
+
+// Clone returns an independent copy of the iterator with the same current
+// vertex and step counter. The clone keeps the vertex chosen by Peek, if
+// any, but the following steps of the walks are chosen independently.
+//
+// If the iterator has an rng, the clone gets its own rng, seeded by a value
+// drawn from the rng of the iterator, so the iterator and the clone can be
+// used in different goroutines, and cloning a seeded iterator is still
+// reproducible.
+func (r *randomWalkIterator[T]) Clone() Iterator[T] {
+	clone := *r
+	clone.path = append([]*gograph.Vertex[T](nil), r.path...)
+	if r.rng != nil {
+		clone.rng = mrand.New(mrand.NewSource(r.rng.Int63()))
+	}
+
+	return &clone
+}
+
 // Reset resets the iterator by setting the initial state of the iterator.
 func (r *randomWalkIterator[T]) Reset() {
 	r.current = r.graph.GetVertexByID(r.start)
 	r.currentStep = 0
+	r.peeked = nil
+	r.path = nil
 }
 
-func (r *randomWalkIterator[T]) randomVertex(v *gograph.Vertex[T]) *gograph.Vertex[T] {
-	if v == nil {
-		return nil
-	}
+// Path returns the vertices that have been returned by Next so far, in the
+// order of the walk. A vertex appears multiple times if the walk revisits it.
+func (r *randomWalkIterator[T]) Path() []*gograph.Vertex[T] {
+	return append([]*gograph.Vertex[T]{}, r.path...)
+}
 
+// weightedRandomVertex chooses one of the neighbors of the input vertex
+// using the rng of the iterator, with a probability proportional to the
+// weight of the edge connecting them. If no edge has a positive weight, it
+// chooses one of them uniformly, so the walk doesn't stop early.
+func (r *randomWalkIterator[T]) weightedRandomVertex(v *gograph.Vertex[T]) *gograph.Vertex[T] {
 	var totalWeight float64
-	var edges []*gograph.Edge[T]
-	neighbors := v.Neighbors()
-
-	// calculate the sum of edge weights
-	for _, neighbor := range neighbors {
-		if edge := r.graph.GetEdge(v, neighbor); edge != nil {
+	var edges, unweighted []*gograph.Edge[T]
+	for _, neighbor := range v.Neighbors() {
+		edge := r.graph.GetEdge(v, neighbor)
+		switch {
+		case edge == nil:
+		case edge.Weight() > 0:
 			edges = append(edges, edge)
 			totalWeight += edge.Weight()
+		default:
+			unweighted = append(unweighted, edge)
 		}
 	}
 
-	// generate a random number between 0 and the sum of edge weights
-	randNum, _ := rand.Int(rand.Reader, big.NewInt(int64(totalWeight)))
-	randWeight := float64(randNum.Int64())
+	if len(edges) == 0 {
+		if len(unweighted) == 0 {
+			return nil
+		}
+
+		return unweighted[r.rng.Intn(len(unweighted))].OtherVertex(v.Label())
+	}
 
-	// find the vertex that corresponds to the random weight
+	// generate a random number in [0, totalWeight)
+	randWeight := r.rng.Float64() * totalWeight
 	for _, edge := range edges {
 		randWeight -= edge.Weight()
 		if randWeight < 0 {
@@ -131,5 +253,13 @@ func (r *randomWalkIterator[T]) randomVertex(v *gograph.Vertex[T]) *gograph.Vert
 		}
 	}
 
-	return nil
+	// the rounding errors of the sum may leave a tiny remainder
+	return edges[len(edges)-1].OtherVertex(v.Label())
+}
+
+// newSeededRand returns a math/rand rng seeded from crypto/rand, for the
+// weighted walks without a user-defined rng.
+func newSeededRand() *mrand.Rand {
+	seed, _ := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+	return mrand.New(mrand.NewSource(seed.Int64()))
 }
@@ -2,6 +2,10 @@ package traverse
 
 import (
 	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/gavinhailey/gograph"
@@ -162,3 +166,170 @@ func TestRandomWalkIterator_RandomVertex(t *testing.T) {
 		t.Errorf("Random vertex %v is outside the range of valid vertices 2,3", randV.Label())
 	}
 }
+
+func TestWeightedRandomWalkIterator(t *testing.T) {
+	// 1 -> 2 (weight 1), 1 -> 3 (weight 3)
+	g := gograph.New[int](gograph.Directed(), gograph.Weighted())
+	v1 := g.AddVertexByLabel(1)
+	_, _ = g.AddEdge(v1, g.AddVertexByLabel(2), gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(v1, g.AddVertexByLabel(3), gograph.WithEdgeWeight(3))
+
+	_, err := NewWeightedRandomWalkIterator(gograph.New[int](), 1, 2, rand.New(rand.NewSource(1)))
+	if !errors.Is(err, ErrNotWeighted) {
+		t.Errorf("Expect %+v error, but got %+v", ErrNotWeighted, err)
+	}
+
+	_, err = NewWeightedRandomWalkIterator(g, 4, 2, rand.New(rand.NewSource(1)))
+	if !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expect %+v error, but got %+v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	rng := rand.New(rand.NewSource(42))
+	it, err := NewWeightedRandomWalkIterator(g, 1, 2, rng)
+	if err != nil {
+		t.Fatalf("Expect NewWeightedRandomWalkIterator doesn't return error, but got %s", err)
+	}
+
+	const walks = 10000
+	counts := make(map[int]int)
+	for i := 0; i < walks; i++ {
+		it.Reset()
+		it.Next() // the start vertex
+		counts[it.Next().Label()]++
+	}
+
+	// the transitions must approximate the weight ratio 1:3
+	ratio := float64(counts[3]) / walks
+	if math.Abs(ratio-0.75) > 0.02 {
+		t.Errorf("Expected transition frequency to 3 to be about 0.75, but got %f", ratio)
+	}
+}
+
+func TestWeightedRandomWalkIterator_Reproducible(t *testing.T) {
+	g := gograph.New[int](gograph.Weighted())
+	for i := 1; i <= 4; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 1; i <= 4; i++ {
+		for j := i + 1; j <= 4; j++ {
+			_, _ = g.AddEdge(g.GetVertexByID(i), g.GetVertexByID(j), gograph.WithEdgeWeight(float64(i*j)))
+		}
+	}
+
+	walk := func(seed int64) []int {
+		it, err := NewWeightedRandomWalkIterator(g, 1, 20, rand.New(rand.NewSource(seed)))
+		if err != nil {
+			t.Fatalf("Expect NewWeightedRandomWalkIterator doesn't return error, but got %s", err)
+		}
+
+		var labels []int
+		_ = it.Iterate(func(v *gograph.Vertex[int]) error {
+			labels = append(labels, v.Label())
+			return nil
+		})
+		return labels
+	}
+
+	first, second := walk(7), walk(7)
+	if len(first) != 20 {
+		t.Errorf("Expected walk of 20 steps, but got %d", len(first))
+	}
+
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Expected the same walk for the same seed, but got %v and %v", first, second)
+	}
+}
+
+func TestWeightedRandomWalkIterator_CloneOwnRNG(t *testing.T) {
+	g := gograph.New[int](gograph.Weighted())
+	for i := 1; i <= 4; i++ {
+		for j := i + 1; j <= 4; j++ {
+			_, _ = g.AddEdge(gograph.NewVertex(i), gograph.NewVertex(j), gograph.WithEdgeWeight(float64(i*j)))
+		}
+	}
+
+	// the walks of the iterator and its clone run concurrently, which the
+	// race detector reports if they share the rng
+	walks := func(seed int64) [2][]int {
+		it, err := NewWeightedRandomWalkIterator(g, 1, 50, rand.New(rand.NewSource(seed)))
+		if err != nil {
+			t.Fatalf("Expect NewWeightedRandomWalkIterator doesn't return error, but got %s", err)
+		}
+
+		var result [2][]int
+		var wg sync.WaitGroup
+		for i, iter := range []Iterator[int]{it.Clone(), it} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = iter.Iterate(func(v *gograph.Vertex[int]) error {
+					result[i] = append(result[i], v.Label())
+					return nil
+				})
+			}()
+		}
+		wg.Wait()
+
+		return result
+	}
+
+	first, second := walks(7), walks(7)
+	if len(first[0]) != 50 || len(first[1]) != 50 {
+		t.Errorf("Expected walks of 50 steps, but got %d and %d", len(first[0]), len(first[1]))
+	}
+
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Expected the same walks for the same seed, but got %v and %v", first, second)
+	}
+}
+
+func TestWeightedRandomWalkIterator_FractionalWeightsNilRNG(t *testing.T) {
+	g := gograph.New[int](gograph.Weighted())
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2), gograph.WithEdgeWeight(0.3))
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(3), gograph.WithEdgeWeight(0.4))
+
+	// the total weight is below one, which must not truncate to zero
+	for name, newIterator := range map[string]func() (Iterator[int], error){
+		"weighted": func() (Iterator[int], error) { return NewWeightedRandomWalkIterator(g, 1, 5, nil) },
+		"default":  func() (Iterator[int], error) { return NewRandomWalkIterator(g, 1, 5) },
+	} {
+		it, err := newIterator()
+		if err != nil {
+			t.Fatalf("%s: Expect no error, but got %s", name, err)
+		}
+
+		for it.HasNext() {
+			if v := it.Next(); v == nil {
+				t.Fatalf("%s: Expected a vertex after HasNext returned true, but got nil", name)
+			}
+		}
+	}
+}
+
+func TestWeightedRandomWalkIterator_ZeroWeights(t *testing.T) {
+	g := gograph.New[int](gograph.Directed(), gograph.Weighted())
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2), gograph.WithEdgeWeight(0))
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(3), gograph.WithEdgeWeight(0))
+	_, _ = g.AddEdge(gograph.NewVertex(2), gograph.NewVertex(1), gograph.WithEdgeWeight(0))
+	_, _ = g.AddEdge(gograph.NewVertex(3), gograph.NewVertex(1), gograph.WithEdgeWeight(0))
+
+	it, err := NewWeightedRandomWalkIterator(g, 1, 20, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("Expect NewWeightedRandomWalkIterator doesn't return error, but got %s", err)
+	}
+
+	// the zero weight edges are chosen uniformly, instead of ending the walk
+	counts := make(map[int]int)
+	for it.HasNext() {
+		v := it.Next()
+		if v == nil {
+			t.Fatal("Expected a vertex after HasNext returned true, but got nil")
+		}
+		counts[v.Label()]++
+	}
+
+	if counts[1] != 10 || counts[2]+counts[3] != 10 {
+		t.Errorf("Expected the walk to alternate between 1 and its neighbors, but got %v", counts)
+	}
+}
@@ -0,0 +1,193 @@
+package traverse
+
+import (
+	"errors"
+
+	"github.com/gavinhailey/gograph"
+)
+
+// ErrVertexAlreadyEmitted is returned when an edge would add a dependency to
+// a vertex that has already been returned by a streaming iterator.
+var ErrVertexAlreadyEmitted = errors.New("vertex has already been emitted")
+
+// StreamingTopologicalIterator traverses a directed acyclic graph in
+// topological order while the graph keeps growing. It returns each vertex
+// as soon as all of its dependencies, the sources of its incoming edges,
+// have been returned, and it accepts new vertices and edges between the
+// calls to Next, e.g. for an incremental build whose targets are discovered
+// while the earlier ones are processed.
+//
+// Since new vertices may become ready later, HasNext returning false only
+// means that no vertex is ready at the moment. The graph must be modified
+// through the iterator, so that it tracks the remaining dependencies.
+type StreamingTopologicalIterator[T comparable] struct {
+	graph     gograph.Graph[T]
+	remaining map[T]int  // the number of dependencies not returned yet, for each pending vertex
+	emitted   map[T]bool // the vertices that have been returned by Next
+	ready     []T        // the pending vertices without remaining dependencies, in FIFO order
+	path      []*gograph.Vertex[T]
+}
+
+// NewStreamingTopologicalIterator creates a new streaming iterator over the
+// specified directed graph, whose vertices without incoming edges are ready
+// to be returned.
+//
+// It returns ErrNotDirected if the graph is undirected, and
+// gograph.ErrDAGHasCycle if the graph has a cycle.
+func NewStreamingTopologicalIterator[T comparable](g gograph.Graph[T]) (*StreamingTopologicalIterator[T], error) {
+	if !g.IsDirected() {
+		return nil, ErrNotDirected
+	}
+
+	order, err := gograph.TopologySort(g)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &StreamingTopologicalIterator[T]{
+		graph:     g,
+		remaining: make(map[T]int, len(order)),
+		emitted:   make(map[T]bool, len(order)),
+	}
+
+	for _, v := range order {
+		s.remaining[v.Label()] = v.InDegree()
+		if v.InDegree() == 0 {
+			s.ready = append(s.ready, v.Label())
+		}
+	}
+
+	return s, nil
+}
+
+// HasNext reports whether a vertex is ready to be returned.
+func (s *StreamingTopologicalIterator[T]) HasNext() bool {
+	return len(s.ready) > 0
+}
+
+// Next returns the next ready vertex, and releases the vertices that only
+// depended on it. If no vertex is ready, it returns nil.
+func (s *StreamingTopologicalIterator[T]) Next() *gograph.Vertex[T] {
+	if !s.HasNext() {
+		return nil
+	}
+
+	label := s.ready[0]
+	s.ready = s.ready[1:]
+
+	v := s.graph.GetVertexByID(label)
+	s.emitted[label] = true
+	delete(s.remaining, label)
+	s.path = append(s.path, v)
+
+	for _, neighbor := range v.Neighbors() {
+		s.remaining[neighbor.Label()]--
+		if s.remaining[neighbor.Label()] == 0 {
+			s.ready = append(s.ready, neighbor.Label())
+		}
+	}
+
+	return v
+}
+
+// Path returns the vertices that have been returned by Next so far, in
+// topological order.
+func (s *StreamingTopologicalIterator[T]) Path() []*gograph.Vertex[T] {
+	path := make([]*gograph.Vertex[T], len(s.path))
+	copy(path, s.path)
+
+	return path
+}
+
+// AddVertex adds a vertex with the specified label to the graph, which is
+// ready to be returned since it has no dependencies. If the vertex already
+// exists, it returns nil.
+func (s *StreamingTopologicalIterator[T]) AddVertex(label T, options ...gograph.VertexOptionFunc) *gograph.Vertex[T] {
+	if s.graph.GetVertexByID(label) != nil {
+		return nil
+	}
+
+	v := s.graph.AddVertexByLabel(label, options...)
+	s.remaining[label] = 0
+	s.ready = append(s.ready, label)
+
+	return v
+}
+
+// AddEdge adds an edge to the graph, which makes the 'to' vertex depend on
+// the 'from' vertex. The vertices are added first if they don't exist. If
+// the 'from' vertex has already been returned, the dependency is already
+// satisfied. Otherwise, the 'to' vertex is held back until it is returned.
+//
+// It returns ErrVertexAlreadyEmitted if the 'to' vertex has already been
+// returned, and gograph.ErrDAGCycle if the edge would create a cycle. The
+// errors of the underlying graph are returned as is.
+func (s *StreamingTopologicalIterator[T]) AddEdge(from, to T, options ...gograph.EdgeOptionFunc) (*gograph.Edge[T], error) {
+	if s.emitted[to] {
+		return nil, ErrVertexAlreadyEmitted
+	}
+
+	// Only the pending vertices can form a new cycle, since all the
+	// dependencies of a returned vertex have been returned before it.
+	if !s.emitted[from] && s.reachesPending(to, from) {
+		return nil, gograph.ErrDAGCycle
+	}
+
+	s.AddVertex(from)
+	s.AddVertex(to)
+
+	edge, err := s.graph.AddEdge(s.graph.GetVertexByID(from), s.graph.GetVertexByID(to), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.emitted[from] {
+		return edge, nil
+	}
+
+	if s.remaining[to] == 0 {
+		for i, label := range s.ready {
+			if label == to {
+				s.ready = append(s.ready[:i], s.ready[i+1:]...)
+				break
+			}
+		}
+	}
+	s.remaining[to]++
+
+	return edge, nil
+}
+
+// reachesPending reports whether the 'to' vertex is reachable from the
+// 'from' vertex through the pending vertices only.
+func (s *StreamingTopologicalIterator[T]) reachesPending(from, to T) bool {
+	if from == to {
+		return true
+	}
+
+	start := s.graph.GetVertexByID(from)
+	if start == nil {
+		return false
+	}
+
+	visited := map[T]bool{from: true}
+	queue := []*gograph.Vertex[T]{start}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+
+		for _, neighbor := range curr.Neighbors() {
+			label := neighbor.Label()
+			if label == to {
+				return true
+			}
+
+			if !visited[label] && !s.emitted[label] {
+				visited[label] = true
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,96 @@
+package traverse
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestStreamingTopologicalIterator(t *testing.T) {
+	g := gograph.New[string](gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"))
+
+	iter, err := NewStreamingTopologicalIterator(g)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var order []string
+	next := func() {
+		t.Helper()
+		if !iter.HasNext() {
+			t.Fatal("Expected a ready vertex")
+		}
+		order = append(order, iter.Next().Label())
+	}
+
+	next() // A
+
+	// B now depends on C, which is not emitted yet
+	if _, err = iter.AddEdge("C", "B"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	next() // C
+	next() // B
+
+	// the dependencies of A are already satisfied
+	if _, err = iter.AddEdge("A", "D"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err = iter.AddEdge("D", "E"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err = iter.AddEdge("E", "D"); !errors.Is(err, gograph.ErrDAGCycle) {
+		t.Errorf("Expected error %v, got %v", gograph.ErrDAGCycle, err)
+	}
+
+	if _, err = iter.AddEdge("E", "A"); !errors.Is(err, ErrVertexAlreadyEmitted) {
+		t.Errorf("Expected error %v, got %v", ErrVertexAlreadyEmitted, err)
+	}
+
+	next() // D
+	next() // E
+
+	if iter.HasNext() || iter.Next() != nil {
+		t.Error("Expected no ready vertex")
+	}
+
+	iter.AddVertex("F")
+	next() // F
+
+	expected := []string{"A", "C", "B", "D", "E", "F"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("Expected order %v, got %v", expected, order)
+	}
+
+	var path []string
+	for _, v := range iter.Path() {
+		path = append(path, v.Label())
+	}
+
+	if !reflect.DeepEqual(path, expected) {
+		t.Errorf("Expected path %v, got %v", expected, path)
+	}
+
+	if g.Order() != 6 || g.Size() != 4 {
+		t.Errorf("Expected 6 vertices and 4 edges, got %d and %d", g.Order(), g.Size())
+	}
+}
+
+func TestStreamingTopologicalIteratorErrors(t *testing.T) {
+	if _, err := NewStreamingTopologicalIterator(gograph.New[int]()); !errors.Is(err, ErrNotDirected) {
+		t.Errorf("Expected error %v, got %v", ErrNotDirected, err)
+	}
+
+	g := gograph.New[int](gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	_, _ = g.AddEdge(gograph.NewVertex(2), gograph.NewVertex(1))
+	if _, err := NewStreamingTopologicalIterator(g); !errors.Is(err, gograph.ErrDAGHasCycle) {
+		t.Errorf("Expected error %v, got %v", gograph.ErrDAGHasCycle, err)
+	}
+}
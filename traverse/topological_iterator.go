@@ -7,9 +7,10 @@ import (
 // topologicalIterator  is an implementation of the Iterator interface
 // for traversing a graph using a topological sort algorithm.
 type topologicalIterator[T comparable] struct {
-	graph gograph.Graph[T]     // the graph being traversed.
-	queue []*gograph.Vertex[T] // a slice that represents the queue of vertices to visit in topological order.
-	head  int                  // the current head of the queue.
+	graph   gograph.Graph[T]     // the graph being traversed.
+	queue   []*gograph.Vertex[T] // a slice that represents the queue of vertices to visit in topological order.
+	head    int                  // the current head of the queue.
+	reverse bool                 // whether the queue is in reverse topological order.
 }
 
 // NewTopologicalIterator creates a new instance of topologicalIterator
@@ -18,17 +19,46 @@ func NewTopologicalIterator[T comparable](g gograph.Graph[T]) (Iterator[T], erro
 	return newTopologicalIterator[T](g)
 }
 
+// NewReverseTopologicalIterator creates a new instance of topologicalIterator
+// that visits the vertices in the reverse of a topological order, so each
+// vertex comes before all of its dependencies and the sinks come first. It
+// is the order for releasing resources, e.g., tearing down services.
+//
+// It returns gograph.ErrDAGHasCycle if the graph has a cycle.
+func NewReverseTopologicalIterator[T comparable](g gograph.Graph[T]) (Iterator[T], error) {
+	iter := &topologicalIterator[T]{graph: g, head: -1, reverse: true}
+	if err := iter.sort(); err != nil {
+		return nil, err
+	}
+
+	return iter, nil
+}
+
 func newTopologicalIterator[T comparable](g gograph.Graph[T]) (*topologicalIterator[T], error) {
-	queue, err := gograph.TopologySort[T](g)
-	if err != nil {
+	iter := &topologicalIterator[T]{graph: g, head: -1}
+	if err := iter.sort(); err != nil {
 		return nil, err
 	}
 
-	return &topologicalIterator[T]{
-		graph: g,
-		queue: queue,
-		head:  -1,
-	}, nil
+	return iter, nil
+}
+
+// sort fills the queue with the topologically sorted vertices of the graph,
+// in reverse if the iterator is reversed.
+func (t *topologicalIterator[T]) sort() error {
+	queue, err := gograph.TopologySort[T](t.graph)
+	if err != nil {
+		return err
+	}
+
+	if t.reverse {
+		for i, j := 0, len(queue)-1; i < j; i, j = i+1, j-1 {
+			queue[i], queue[j] = queue[j], queue[i]
+		}
+	}
+
+	t.queue = queue
+	return nil
 }
 
 // HasNext returns a boolean indicating whether there are more vertices
@@ -49,6 +79,16 @@ func (t *topologicalIterator[T]) Next() *gograph.Vertex[T] {
 	return t.queue[t.head]
 }
 
+// Peek returns the next vertex to be visited in the topological order
+// without advancing the iterator. If the HasNext is false, returns nil.
+func (t *topologicalIterator[T]) Peek() *gograph.Vertex[T] {
+	if !t.HasNext() {
+		return nil
+	}
+
+	return t.queue[t.head+1]
+}
+
 // Iterate iterates through all the vertices in the BFS traversal order
 // and applies the given function to each vertex. If the function returns
 // an error, the iteration stops and the error is returned.
@@ -62,15 +102,45 @@ func (t *topologicalIterator[T]) Iterate(f func(v *gograph.Vertex[T]) error) err
 	return nil
 }
 
+// IterateN iterates over at most n of the remaining vertices and calls the
+// callback function on each of them. It returns the number of visited
+// vertices, and the iterator continues after them on the next call.
+func (t *topologicalIterator[T]) IterateN(n int, f func(v *gograph.Vertex[T]) error) (int, error) {
+	var visited int
+	for visited < n && t.HasNext() {
+		visited++
+		if err := f(t.Next()); err != nil {
+			return visited, err
+		}
+	}
+
+	return visited, nil
+}
+
+// Path returns the vertices that have been returned by Next so far,
+// in the topological order.
+func (t *topologicalIterator[T]) Path() []*gograph.Vertex[T] {
+	return append([]*gograph.Vertex[T]{}, t.queue[:t.head+1]...)
+}
+
+// Clone returns an independent copy of the iterator with the same sorted
+// queue and head.
+func (t *topologicalIterator[T]) Clone() Iterator[T] {
+	return &topologicalIterator[T]{
+		graph:   t.graph,
+		queue:   append([]*gograph.Vertex[T](nil), t.queue...),
+		head:    t.head,
+		reverse: t.reverse,
+	}
+}
+
 // Reset resets the iterator by setting the initial state of the iterator.
 // It calls the gograph.TopologySort again. If topology sort returns
 // error, it panics.
 func (t *topologicalIterator[T]) Reset() {
 	t.head = -1
 
-	var err error
-	t.queue, err = gograph.TopologySort[T](t.graph)
-	if err != nil {
+	if err := t.sort(); err != nil {
 		panic(err)
 	}
 }
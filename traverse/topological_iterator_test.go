@@ -2,6 +2,7 @@ package traverse
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/gavinhailey/gograph"
@@ -115,3 +116,75 @@ func TestTopologyOrderIterator_NotAcyclic(t *testing.T) {
 	_, _ = g2.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
 	_, _ = g2.AddEdge(gograph.NewVertex(2), gograph.NewVertex(3))
 }
+
+func TestReverseTopologicalIterator(t *testing.T) {
+	// the transitive edges make the topological order unique
+	g := gograph.New[int](gograph.Acyclic())
+	for i := 1; i <= 5; i++ {
+		for j := i + 1; j <= 5; j++ {
+			_, _ = g.AddEdge(gograph.NewVertex(i), gograph.NewVertex(j))
+		}
+	}
+
+	forward, err := NewTopologicalIterator[int](g)
+	if err != nil {
+		t.Fatalf("Expect no error by calling NewTopologicalIterator, but got one, %s", err)
+	}
+
+	reverse, err := NewReverseTopologicalIterator[int](g)
+	if err != nil {
+		t.Fatalf("Expect no error by calling NewReverseTopologicalIterator, but got one, %s", err)
+	}
+
+	labelsOf := func(iter Iterator[int]) []int {
+		var labels []int
+		_ = iter.Iterate(func(v *gograph.Vertex[int]) error {
+			labels = append(labels, v.Label())
+			return nil
+		})
+		return labels
+	}
+
+	var order []int
+	_ = forward.Iterate(func(v *gograph.Vertex[int]) error {
+		order = append([]int{v.Label()}, order...)
+		return nil
+	})
+
+	if got := labelsOf(reverse); !reflect.DeepEqual(got, order) {
+		t.Errorf("Expected %v, but got %v", order, got)
+	}
+
+	// the clone and the reset iterator keep the reverse order
+	reverse.Reset()
+	reverse.Next()
+	if got := labelsOf(reverse.Clone()); !reflect.DeepEqual(got, order[1:]) {
+		t.Errorf("Expected %v, but got %v", order[1:], got)
+	}
+
+	_, _ = g.AddEdge(gograph.NewVertex(5), gograph.NewVertex(6))
+	_, _ = g.AddEdge(gograph.NewVertex(2), gograph.NewVertex(7))
+	reverse.Reset()
+
+	// every vertex comes before its dependencies
+	position := make(map[int]int)
+	for i, label := range labelsOf(reverse) {
+		position[label] = i
+	}
+
+	for _, edge := range g.AllEdges() {
+		if position[edge.Source().Label()] < position[edge.Destination().Label()] {
+			t.Errorf("Expected %d before %d", edge.Destination().Label(), edge.Source().Label())
+		}
+	}
+}
+
+func TestReverseTopologicalIterator_NotAcyclic(t *testing.T) {
+	g := gograph.New[int](gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	_, _ = g.AddEdge(gograph.NewVertex(2), gograph.NewVertex(1))
+
+	if _, err := NewReverseTopologicalIterator[int](g); !errors.Is(err, gograph.ErrDAGHasCycle) {
+		t.Errorf("Expect %+v error, but got %+v", gograph.ErrDAGHasCycle, err)
+	}
+}
@@ -0,0 +1,91 @@
+package tree
+
+import (
+	"errors"
+
+	"github.com/gavinhailey/gograph"
+)
+
+var (
+	ErrNotTree = errors.New("graph is not a tree")
+)
+
+// MaxWeightIndependentSetTree finds an independent set of the specified tree
+// with the maximum total vertex weight, which means no two vertices of the
+// set are adjacent. It returns the total weight and the vertices of the set.
+//
+// It roots the tree at an arbitrary vertex and solves the classic dynamic
+// programming in linear time: for each vertex, it computes the best weight
+// of its subtree when the vertex is included, and when it is excluded.
+// Vertices with negative weight are never chosen.
+//
+// The result of an empty graph is zero with no vertices.
+//
+// It returns ErrNotTree if the graph is directed, disconnected, or contains
+// a cycle.
+func MaxWeightIndependentSetTree[T comparable](g gograph.Graph[T]) (float64, []*gograph.Vertex[T], error) {
+	vertices := g.GetAllVertices()
+	if len(vertices) == 0 {
+		return 0, nil, nil
+	}
+
+	// undirected edges are stored in both directions
+	if g.IsDirected() || int(g.Size()) != 2*(len(vertices)-1) {
+		return 0, nil, ErrNotTree
+	}
+
+	// Find an order in which each vertex comes after its parent
+	root := vertices[0]
+	parents := map[T]T{root.Label(): root.Label()}
+	order := []*gograph.Vertex[T]{root}
+	for i := 0; i < len(order); i++ {
+		curr := order[i]
+		for _, neighbor := range curr.Neighbors() {
+			if _, ok := parents[neighbor.Label()]; ok {
+				continue
+			}
+
+			parents[neighbor.Label()] = curr.Label()
+			order = append(order, g.GetVertexByID(neighbor.Label()))
+		}
+	}
+
+	// A graph with |V|-1 edges is a tree if and only if it is connected
+	if len(order) != len(vertices) {
+		return 0, nil, ErrNotTree
+	}
+
+	// Compute the best weights of the subtrees bottom-up
+	included := make(map[T]float64, len(vertices))
+	excluded := make(map[T]float64, len(vertices))
+	for i := len(order) - 1; i >= 0; i-- {
+		v := order[i]
+		included[v.Label()] += v.Weight()
+
+		if i == 0 {
+			break
+		}
+
+		parent := parents[v.Label()]
+		included[parent] += excluded[v.Label()]
+		excluded[parent] += max(included[v.Label()], excluded[v.Label()])
+	}
+
+	// Choose the vertices top-down, a vertex can be chosen only
+	// if its parent is not chosen.
+	chosen := make(map[T]bool, len(vertices))
+	set := make([]*gograph.Vertex[T], 0)
+	for i, v := range order {
+		label := v.Label()
+		if i > 0 && chosen[parents[label]] {
+			continue
+		}
+
+		if included[label] > excluded[label] {
+			chosen[label] = true
+			set = append(set, v)
+		}
+	}
+
+	return max(included[root.Label()], excluded[root.Label()]), set, nil
+}
@@ -0,0 +1,89 @@
+package tree
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestMaxWeightIndependentSetTree(t *testing.T) {
+	// The following tree with vertex weights in parentheses:
+	//
+	//          1(5)
+	//        /     \
+	//     2(4)      3(3)
+	//    /   \        \
+	//  4(2)  5(3)     6(6)
+	//
+	// The optimum is {1, 4, 5, 6} with total weight 5+2+3+6 = 16.
+	weights := map[int]float64{1: 5, 2: 4, 3: 3, 4: 2, 5: 3, 6: 6}
+
+	g := gograph.New[int]()
+	for label, weight := range weights {
+		g.AddVertexByLabel(label, gograph.WithVertexWeight(weight))
+	}
+
+	for _, e := range [][2]int{{1, 2}, {1, 3}, {2, 4}, {2, 5}, {3, 6}} {
+		_, _ = g.AddEdge(g.GetVertexByID(e[0]), g.GetVertexByID(e[1]))
+	}
+
+	weight, set, err := MaxWeightIndependentSetTree(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if weight != 16 {
+		t.Errorf("expected total weight 16, got %v", weight)
+	}
+
+	labels := make([]int, 0, len(set))
+	for _, v := range set {
+		labels = append(labels, v.Label())
+	}
+	sort.Ints(labels)
+
+	expected := []int{1, 4, 5, 6}
+	if len(labels) != len(expected) {
+		t.Fatalf("expected set %v, got %v", expected, labels)
+	}
+
+	for i := range expected {
+		if labels[i] != expected[i] {
+			t.Fatalf("expected set %v, got %v", expected, labels)
+		}
+	}
+}
+
+func TestMaxWeightIndependentSetTreeNotTree(t *testing.T) {
+	cycle := gograph.New[int]()
+	_, _ = cycle.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	_, _ = cycle.AddEdge(gograph.NewVertex(2), gograph.NewVertex(3))
+	_, _ = cycle.AddEdge(gograph.NewVertex(3), gograph.NewVertex(1))
+
+	// a triangle and an isolated vertex have |V|-1 edges
+	disconnected := gograph.New[int]()
+	_, _ = disconnected.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	_, _ = disconnected.AddEdge(gograph.NewVertex(2), gograph.NewVertex(3))
+	_, _ = disconnected.AddEdge(gograph.NewVertex(3), gograph.NewVertex(1))
+	disconnected.AddVertexByLabel(4)
+
+	directed := gograph.New[int](gograph.Directed())
+	_, _ = directed.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+
+	tests := map[string]gograph.Graph[int]{
+		"cycle":        cycle,
+		"disconnected": disconnected,
+		"directed":     directed,
+	}
+
+	for name, g := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := MaxWeightIndependentSetTree(g)
+			if !errors.Is(err, ErrNotTree) {
+				t.Errorf("expected error %v, got %v", ErrNotTree, err)
+			}
+		})
+	}
+}
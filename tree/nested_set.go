@@ -0,0 +1,82 @@
+package tree
+
+import (
+	"github.com/gavinhailey/gograph"
+)
+
+// NestedSetEncoding computes the nested set model of the tree rooted at the
+// root vertex, which is the classic way to store a hierarchy in a SQL table.
+// It maps each vertex to its left and right bounds, which are numbered in
+// the order a DFS from the root enters and leaves the vertices, starting
+// from 1. The bounds of each vertex contain the bounds of all its
+// descendants, so the subtree of a vertex is a range query. The root gets
+// the bounds [1, 2V], and the leaves get consecutive bounds. The children of
+// each vertex are visited in the order of its neighbors.
+//
+// A directed graph must be an arborescence: each vertex other than the root
+// has exactly one incoming edge, and all of them are reachable from the
+// root. An undirected graph must be connected and acyclic.
+//
+// It returns gograph.ErrVertexDoesNotExist if the root doesn't exist, and
+// ErrNotTree if the graph is not a tree rooted at the root.
+func NestedSetEncoding[T comparable](g gograph.Graph[T], root T) (map[T][2]int, error) {
+	r := g.GetVertexByID(root)
+	if r == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	// undirected edges are stored in both directions
+	n := int(g.Order())
+	edges := n - 1
+	if !g.IsDirected() {
+		edges *= 2
+	}
+
+	if int(g.Size()) != edges {
+		return nil, ErrNotTree
+	}
+
+	type frame struct {
+		vertex    *gograph.Vertex[T]
+		parent    T
+		neighbors []*gograph.Vertex[T]
+		next      int // the index of the next neighbor to visit
+	}
+
+	bounds := make(map[T][2]int, n)
+	counter := 1
+	bounds[root] = [2]int{counter, 0}
+	stack := []frame{{vertex: r, neighbors: r.Neighbors()}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if top.next == len(top.neighbors) {
+			counter++
+			bounds[top.vertex.Label()] = [2]int{bounds[top.vertex.Label()][0], counter}
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		child := top.neighbors[top.next]
+		top.next++
+
+		// the edge back to the parent of an undirected tree
+		if !g.IsDirected() && len(stack) > 1 && child.Label() == top.parent {
+			continue
+		}
+
+		// the child is reached twice, so the graph has a cycle
+		if _, ok := bounds[child.Label()]; ok {
+			return nil, ErrNotTree
+		}
+
+		counter++
+		bounds[child.Label()] = [2]int{counter, 0}
+		stack = append(stack, frame{vertex: child, parent: top.vertex.Label(), neighbors: child.Neighbors()})
+	}
+
+	if len(bounds) != n {
+		return nil, ErrNotTree
+	}
+
+	return bounds, nil
+}
@@ -0,0 +1,100 @@
+package tree
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gavinhailey/gograph"
+)
+
+func TestNestedSetEncoding(t *testing.T) {
+	// The following hierarchy:
+	//
+	//            root
+	//          /      \
+	//        a          b
+	//      /   \         \
+	//    a1     a2        b1
+	edges := [][2]string{{"root", "a"}, {"root", "b"}, {"a", "a1"}, {"a", "a2"}, {"b", "b1"}}
+
+	for _, directed := range []bool{true, false} {
+		var options []gograph.GraphOptionFunc
+		if directed {
+			options = append(options, gograph.Directed())
+		}
+
+		g := gograph.New[string](options...)
+		for _, e := range edges {
+			_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+		}
+
+		bounds, err := NestedSetEncoding(g, "root")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if bounds["root"] != [2]int{1, 12} {
+			t.Errorf("expected root bounds [1 12], got %v", bounds["root"])
+		}
+
+		// each parent contains its children, and the siblings don't overlap
+		for _, e := range edges {
+			parent, child := bounds[e[0]], bounds[e[1]]
+			if child[0] <= parent[0] || child[1] >= parent[1] {
+				t.Errorf("expected %v %v to contain %v %v", e[0], parent, e[1], child)
+			}
+		}
+
+		for _, siblings := range [][2]string{{"a", "b"}, {"a1", "a2"}} {
+			x, y := bounds[siblings[0]], bounds[siblings[1]]
+			if x[1] > y[0] && y[1] > x[0] {
+				t.Errorf("expected %v %v and %v %v to be disjoint", siblings[0], x, siblings[1], y)
+			}
+		}
+
+		// the leaves get consecutive bounds
+		for _, leaf := range []string{"a1", "a2", "b1"} {
+			if bounds[leaf][1] != bounds[leaf][0]+1 {
+				t.Errorf("expected consecutive bounds of %s, got %v", leaf, bounds[leaf])
+			}
+		}
+	}
+}
+
+func TestNestedSetEncodingNotTree(t *testing.T) {
+	tests := []struct {
+		name     string
+		directed bool
+		edges    [][2]string
+		root     string
+	}{
+		{"directed cycle", true, [][2]string{{"r", "a"}, {"a", "b"}, {"b", "a"}}, "r"},
+		{"directed two parents", true, [][2]string{{"r", "a"}, {"r", "b"}, {"a", "c"}, {"b", "c"}}, "r"},
+		{"directed unreachable cycle", true, [][2]string{{"r", "a"}, {"b", "c"}, {"c", "b"}}, "r"},
+		{"directed wrong root", true, [][2]string{{"r", "a"}, {"a", "b"}}, "a"},
+		{"undirected cycle", false, [][2]string{{"r", "a"}, {"a", "b"}, {"b", "r"}}, "r"},
+		{"undirected disconnected", false, [][2]string{{"r", "a"}, {"a", "b"}, {"c", "d"}, {"d", "e"}, {"e", "c"}}, "r"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var options []gograph.GraphOptionFunc
+			if tt.directed {
+				options = append(options, gograph.Directed())
+			}
+
+			g := gograph.New[string](options...)
+			for _, e := range tt.edges {
+				_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+			}
+
+			if _, err := NestedSetEncoding(g, tt.root); !errors.Is(err, ErrNotTree) {
+				t.Errorf("expected error %v, got %v", ErrNotTree, err)
+			}
+		})
+	}
+
+	if _, err := NestedSetEncoding(gograph.New[string](), "x"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %v, got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+}
@@ -0,0 +1,40 @@
+package gograph
+
+// TrimUnreachable returns a new graph that contains only the vertices that
+// are reachable from any of the root vertices, along with the edges between
+// them, dropping the orphaned subgraphs. It is useful for eliminating dead
+// code in dependency graphs. The roots are always part of the result.
+//
+// The returned graph has the same properties as the input graph, the vertex
+// and edge weights are preserved, and the input graph is not modified.
+//
+// It returns ErrVertexDoesNotExist if any of the roots doesn't exist.
+func TrimUnreachable[T comparable](g Graph[T], roots []T) (Graph[T], error) {
+	reachable := make(map[T]bool)
+	var stack []*Vertex[T]
+	for _, label := range roots {
+		v := g.GetVertexByID(label)
+		if v == nil {
+			return nil, ErrVertexDoesNotExist
+		}
+
+		if !reachable[label] {
+			reachable[label] = true
+			stack = append(stack, v)
+		}
+	}
+
+	for len(stack) > 0 {
+		curr := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, neighbor := range curr.adjacent() {
+			if !reachable[neighbor.label] {
+				reachable[neighbor.label] = true
+				stack = append(stack, neighbor)
+			}
+		}
+	}
+
+	return induced(g, func(label T) bool { return reachable[label] }), nil
+}
@@ -0,0 +1,58 @@
+package gograph
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestTrimUnreachable(t *testing.T) {
+	// main -> a -> b, main -> c, and the orphaned subgraphs x -> y -> b
+	// and z, which are not reachable from the roots.
+	g := New[string](Acyclic(), Weighted())
+	edges := [][2]string{{"main", "a"}, {"a", "b"}, {"main", "c"}, {"x", "y"}, {"y", "b"}}
+	for _, e := range edges {
+		_, _ = g.AddEdge(NewVertex(e[0]), NewVertex(e[1]), WithEdgeWeight(2))
+	}
+	g.AddVertexByLabel("z")
+	g.AddVertexByLabel("test", WithVertexWeight(3))
+
+	trimmed, err := TrimUnreachable(g, []string{"main", "test"})
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	labels := extractLabels(trimmed.GetAllVertices())
+	sort.Strings(labels)
+	expected := []string{"a", "b", "c", "main", "test"}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Errorf(testErrMsgNotEqual, expected, labels)
+	}
+
+	if trimmed.Size() != 3 {
+		t.Errorf(testErrMsgWrongLen, 3, trimmed.Size())
+	}
+
+	if !trimmed.IsAcyclic() || !trimmed.IsWeighted() {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	if w := trimmed.GetVertexByID("test").Weight(); w != 3 {
+		t.Errorf(testErrMsgNotEqual, 3, w)
+	}
+
+	// the input graph is untouched
+	if g.Order() != 8 || g.Size() != 5 {
+		t.Error("expected the input graph to be unchanged")
+	}
+}
+
+func TestTrimUnreachableMissingRoot(t *testing.T) {
+	g := New[string](Directed())
+	_, _ = g.AddEdge(NewVertex("a"), NewVertex("b"))
+
+	if _, err := TrimUnreachable(g, []string{"a", "missing"}); !errors.Is(err, ErrVertexDoesNotExist) {
+		t.Errorf(testErrMsgNotEqual, ErrVertexDoesNotExist, err)
+	}
+}
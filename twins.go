@@ -0,0 +1,119 @@
+package gograph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MergeTwins finds the twin vertices of the graph, and merges each group of
+// twins into one vertex. It returns the simplified graph, and a mapping from
+// the label of each removed vertex to the label of the vertex it was merged
+// into. The input graph is not modified.
+//
+// Two vertices are twins if they have exactly the same set of neighbors,
+// regardless of the edge weights. In directed graphs, the neighbors are the
+// out-neighbors, so for example all the sinks are twins of each other. In
+// undirected graphs, two adjacent vertices are never twins, since each one
+// is a neighbor of the other one only.
+//
+// In each group, the vertex with the smallest fmt representation of its
+// label is kept. The edges pointing to a removed vertex are redirected to
+// the kept vertex, unless the same edge already exists. The kept vertex
+// keeps its own weight and the weights of its own edges. Twins are found in
+// a single pass, so merging may create new twins which are not merged.
+func MergeTwins[T comparable](g Graph[T]) (Graph[T], map[T]T, error) {
+	vertices := g.GetAllVertices()
+	sort.Slice(vertices, func(i, j int) bool {
+		return fmt.Sprint(vertices[i].label) < fmt.Sprint(vertices[j].label)
+	})
+
+	// group the vertices by the rendered labels of their neighbors, and
+	// compare the actual neighbor sets in each group.
+	merged := make(map[T]T)
+	kept := make(map[string][]*Vertex[T])
+	for _, v := range vertices {
+		key := neighborhoodKey(v)
+
+		var twin *Vertex[T]
+		for _, candidate := range kept[key] {
+			if sameNeighbors(v, candidate) {
+				twin = candidate
+				break
+			}
+		}
+
+		if twin == nil {
+			kept[key] = append(kept[key], v)
+			continue
+		}
+
+		merged[v.label] = twin.label
+	}
+
+	simplified := induced(g, func(label T) bool {
+		_, ok := merged[label]
+		return !ok
+	})
+
+	for _, edge := range g.AllEdges() {
+		to, ok := merged[edge.dest.label]
+		if !ok {
+			continue
+		}
+
+		// the kept twin already has the edges of a removed source
+		if _, ok := merged[edge.source.label]; ok {
+			continue
+		}
+
+		if simplified.HasEdge(edge.source.label, to) {
+			continue
+		}
+
+		_, err := simplified.AddEdge(
+			simplified.vertices[edge.source.label],
+			simplified.vertices[to],
+			WithEdgeWeight(edge.Weight()),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return simplified, merged, nil
+}
+
+// neighborhoodKey returns the sorted fmt representations of the neighbors
+// of the vertex, which is equal for twins.
+func neighborhoodKey[T comparable](v *Vertex[T]) string {
+	neighbors := v.adjacent()
+	labels := make([]string, len(neighbors))
+	for i, neighbor := range neighbors {
+		labels[i] = fmt.Sprint(neighbor.label)
+	}
+
+	sort.Strings(labels)
+	return strings.Join(labels, "\x00")
+}
+
+// sameNeighbors reports whether the vertices have the same set of neighbors.
+func sameNeighbors[T comparable](a, b *Vertex[T]) bool {
+	aNeighbors, bNeighbors := a.adjacent(), b.adjacent()
+	if len(aNeighbors) != len(bNeighbors) {
+		return false
+	}
+
+	neighbors := make(map[T]bool, len(aNeighbors))
+	for _, neighbor := range aNeighbors {
+		neighbors[neighbor.label] = true
+	}
+
+	for _, neighbor := range bNeighbors {
+		if !neighbors[neighbor.label] {
+			return false
+		}
+	}
+
+	return true
+}
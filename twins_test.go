@@ -0,0 +1,80 @@
+package gograph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeTwins(t *testing.T) {
+	// B and C are both connected to A and D only
+	g := New[string]()
+	_, _ = g.AddEdge(NewVertex("A"), NewVertex("B"))
+	_, _ = g.AddEdge(NewVertex("A"), NewVertex("C"))
+	_, _ = g.AddEdge(NewVertex("B"), NewVertex("D"))
+	_, _ = g.AddEdge(NewVertex("C"), NewVertex("D"))
+	_, _ = g.AddEdge(NewVertex("D"), NewVertex("E"))
+
+	simplified, merged, err := MergeTwins(g)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	// A and D are not twins, since D is also connected to E
+	if !reflect.DeepEqual(merged, map[string]string{"C": "B"}) {
+		t.Errorf(testErrMsgNotEqual, map[string]string{"C": "B"}, merged)
+	}
+
+	if simplified.Order() != 4 || simplified.GetVertexByID("C") != nil {
+		t.Errorf(testErrMsgWrongLen, 4, simplified.Order())
+	}
+
+	if !simplified.HasEdge("A", "B") || !simplified.HasEdge("B", "D") || !simplified.HasEdge("D", "E") {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	if g.Order() != 5 || g.GetVertexByID("C") == nil {
+		t.Error("Expected the input graph not to be modified")
+	}
+}
+
+func TestMergeTwinsDirected(t *testing.T) {
+	// B and C have the same out-neighbors, but different in-neighbors
+	g := New[string](Directed(), Weighted())
+	_, _ = g.AddEdge(NewVertex("A"), NewVertex("B"), WithEdgeWeight(1))
+	_, _ = g.AddEdge(NewVertex("X"), NewVertex("C"), WithEdgeWeight(2))
+	_, _ = g.AddEdge(NewVertex("B"), NewVertex("D"), WithEdgeWeight(3))
+	_, _ = g.AddEdge(NewVertex("C"), NewVertex("D"), WithEdgeWeight(4))
+	_, _ = g.AddEdge(NewVertex("D"), NewVertex("E"), WithEdgeWeight(5))
+	_, _ = g.AddEdge(NewVertex("D"), NewVertex("F"), WithEdgeWeight(6))
+
+	simplified, merged, err := MergeTwins(g)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	// the sinks E and F are twins as well
+	expected := map[string]string{"C": "B", "F": "E"}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Errorf(testErrMsgNotEqual, expected, merged)
+	}
+
+	if simplified.Order() != 5 {
+		t.Errorf(testErrMsgWrongLen, 5, simplified.Order())
+	}
+
+	// the in-edge of C is redirected to B with its weight
+	edge := simplified.GetEdge(simplified.GetVertexByID("X"), simplified.GetVertexByID("B"))
+	if edge == nil || edge.Weight() != 2 {
+		t.Errorf(testErrMsgNotEqual, 2, edge)
+	}
+
+	// B keeps its own out-edge weight
+	edge = simplified.GetEdge(simplified.GetVertexByID("B"), simplified.GetVertexByID("D"))
+	if edge == nil || edge.Weight() != 3 {
+		t.Errorf(testErrMsgNotEqual, 3, edge)
+	}
+
+	if simplified.Size() != 4 {
+		t.Errorf(testErrMsgWrongLen, 4, simplified.Size())
+	}
+}
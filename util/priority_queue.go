@@ -41,6 +41,18 @@ func (v *VertexPriorityQueue[T]) Peek() *VertexWithPriority[T] {
 	return nil
 }
 
+// Clone returns an independent copy of the queue. The vertices are shared,
+// but the items of the queue are copied.
+func (v *VertexPriorityQueue[T]) Clone() *VertexPriorityQueue[T] {
+	pq := make(priorityQueue[T], len(v.pq))
+	for i := range v.pq {
+		item := *v.pq[i]
+		pq[i] = &item
+	}
+
+	return &VertexPriorityQueue[T]{pq: pq}
+}
+
 // Len is the number of elements in the underlying queue.
 func (v *VertexPriorityQueue[T]) Len() int {
 	return len(v.pq)
@@ -83,3 +83,28 @@ func TestVertexPriorityQueue(t *testing.T) {
 		t.Errorf("Expected Peek returns nil, but got %v", vpq.Peek())
 	}
 }
+
+func TestVertexPriorityQueue_Clone(t *testing.T) {
+	vpq := NewVertexPriorityQueue[string]()
+	vpq.Push(NewVertexWithPriority(gograph.NewVertex("A"), 2))
+	vpq.Push(NewVertexWithPriority(gograph.NewVertex("B"), 1))
+
+	clone := vpq.Clone()
+	clone.Push(NewVertexWithPriority(gograph.NewVertex("C"), 0))
+
+	if clone.Pop().Vertex().Label() != "C" {
+		t.Error("Expected clone to pop C first")
+	}
+
+	if vpq.Len() != 2 {
+		t.Errorf("Expected original length 2, but got %d", vpq.Len())
+	}
+
+	if vpq.Pop().Vertex().Label() != "B" || vpq.Pop().Vertex().Label() != "A" {
+		t.Error("Expected original queue to be unchanged by the clone")
+	}
+
+	if clone.Len() != 2 {
+		t.Errorf("Expected clone length 2, but got %d", clone.Len())
+	}
+}
@@ -0,0 +1,55 @@
+package gograph
+
+// VertexAttribute is a typed side table that associates a value of type A
+// with the vertices of a graph. It keeps the attributes decoupled from the
+// Vertex type, so any number of independent attributes of different types
+// can be attached to the same graph.
+//
+// The attributes are keyed by vertex label. A value is only visible while
+// its vertex exists in the graph, so removing a vertex hides its value. The
+// value is kept, though, and it is visible again if a vertex with the same
+// label is added back, unless it is removed by Delete.
+//
+// Like the graph itself, it is not safe for concurrent use.
+type VertexAttribute[T comparable, A any] struct {
+	graph  Graph[T]
+	values map[T]A
+}
+
+// NewVertexAttribute creates an empty attribute table for the vertices of
+// the specified graph.
+func NewVertexAttribute[T comparable, A any](g Graph[T]) *VertexAttribute[T, A] {
+	return &VertexAttribute[T, A]{
+		graph:  g,
+		values: make(map[T]A),
+	}
+}
+
+// Set sets the value of the attribute for the vertex with the specified
+// label. It does nothing if the vertex doesn't exist in the graph.
+func (a *VertexAttribute[T, A]) Set(label T, value A) {
+	if a.graph.GetVertexByID(label) == nil {
+		return
+	}
+
+	a.values[label] = value
+}
+
+// Get returns the value of the attribute for the vertex with the specified
+// label, and reports whether it is set. It returns the zero value and false
+// if the value is not set or the vertex doesn't exist in the graph.
+func (a *VertexAttribute[T, A]) Get(label T) (A, bool) {
+	value, ok := a.values[label]
+	if !ok || a.graph.GetVertexByID(label) == nil {
+		var zero A
+		return zero, false
+	}
+
+	return value, true
+}
+
+// Delete removes the value of the attribute for the vertex with the
+// specified label.
+func (a *VertexAttribute[T, A]) Delete(label T) {
+	delete(a.values, label)
+}
@@ -0,0 +1,56 @@
+package gograph
+
+import "testing"
+
+func TestVertexAttribute(t *testing.T) {
+	g := New[string]()
+	_, _ = g.AddEdge(NewVertex("A"), NewVertex("B"))
+	g.AddVertexByLabel("C")
+
+	type position struct{ x, y int }
+
+	names := NewVertexAttribute[string, string](g)
+	positions := NewVertexAttribute[string, position](g)
+
+	names.Set("A", "alpha")
+	names.Set("B", "beta")
+	positions.Set("A", position{x: 1, y: 2})
+
+	if name, ok := names.Get("A"); !ok || name != "alpha" {
+		t.Errorf(testErrMsgNotEqual, "alpha", name)
+	}
+
+	if pos, ok := positions.Get("A"); !ok || pos != (position{x: 1, y: 2}) {
+		t.Errorf(testErrMsgNotEqual, position{x: 1, y: 2}, pos)
+	}
+
+	// the attributes are independent
+	if _, ok := positions.Get("B"); ok {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	if pos, ok := positions.Get("C"); ok || pos != (position{}) {
+		t.Errorf("Expected zero value and false, got %v, %v", pos, ok)
+	}
+
+	// a vertex that doesn't exist can't be set
+	names.Set("D", "delta")
+	if _, ok := names.Get("D"); ok {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	// removing a vertex hides its values
+	g.RemoveVertices(g.GetVertexByID("A"))
+	if _, ok := names.Get("A"); ok {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	if _, ok := positions.Get("A"); ok {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	names.Delete("B")
+	if _, ok := names.Get("B"); ok {
+		t.Error(testErrMsgNotFalse)
+	}
+}